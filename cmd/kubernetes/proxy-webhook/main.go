@@ -17,6 +17,8 @@ limitations under the License.
 package main
 
 import (
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/eventlistener"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/namespace"
 	"github.com/tektoncd/operator/pkg/reconciler/proxy"
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/injection/sharedmain"
@@ -28,5 +30,7 @@ func main() {
 		injection.ParseAndGetRESTConfigOrDie(),
 		certificates.NewController,
 		proxy.NewProxyDefaultingAdmissionController,
+		namespace.NewNamespaceAdmissionController,
+		eventlistener.NewEventListenerDefaultingAdmissionController,
 	)
 }
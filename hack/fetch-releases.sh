@@ -354,6 +354,21 @@ fetch_openshift_addon_tasks() {
   ${fetch_addon_task_script}/fetch-tektoncd-catalog-tasks.sh ${dest_dir} "ecosystem_stepactions"
 }
 
+# the kubernetes addon installs the same resolver tasks and stepactions as on openshift,
+# except the tasks which need openshift (s2i and openshift client)
+fetch_kubernetes_addon_tasks() {
+  fetch_addon_task_script="${SCRIPT_DIR}/hack/openshift"
+  local openshift_dir='cmd/openshift/operator/kodata/tekton-addon/addons/06-ecosystem'
+  local dest_dir='cmd/kubernetes/operator/kodata/tekton-addon/addons/06-ecosystem'
+  rm -rf ${dest_dir}
+  ${fetch_addon_task_script}/fetch-tektoncd-catalog-tasks.sh ${dest_dir}/tasks "ecosystem_tasks"
+  rm -rf ${dest_dir}/tasks/task-s2i-* ${dest_dir}/tasks/task-openshift-client ${dest_dir}/tasks/task-opc
+  ${fetch_addon_task_script}/fetch-tektoncd-catalog-tasks.sh ${dest_dir}/stepactions "ecosystem_stepactions"
+  # rbac to list the tasks and stepactions of the target namespace
+  cp ${openshift_dir}/tasks/role.yaml ${openshift_dir}/tasks/rolebinding.yaml ${dest_dir}/tasks/
+  cp ${openshift_dir}/stepactions/role.yaml ${openshift_dir}/stepactions/rolebinding.yaml ${dest_dir}/stepactions/
+}
+
 copy_pruner_yaml() {
   srcPath=${SCRIPT_DIR}/config/pruner
   ko_data=${SCRIPT_DIR}/cmd/${TARGET}/operator/kodata
@@ -402,6 +417,7 @@ main() {
     # get release YAML for Dashboard
     release_yaml dashboard release-full 00-dashboard ${d_version}
     release_yaml dashboard release 00-dashboard ${d_version}
+    fetch_kubernetes_addon_tasks
   else
     pac_version=$(go run ./cmd/tool component-version ${CONFIG} pipelines-as-code)
     release_yaml_pac pipelinesascode release ${pac_version}
@@ -18,10 +18,17 @@ package v1alpha1
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"knative.dev/pkg/apis"
 )
 
+// cronFieldPattern matches a single field of a standard cron schedule, names of months and days are not supported
+var cronFieldPattern = regexp.MustCompile(`^(\*|[0-9]+(-[0-9]+)?)(/[0-9]+)?(,(\*|[0-9]+(-[0-9]+)?)(/[0-9]+)?)*$`)
+
+var cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
 func (ta *CommonSpec) validate(path string) *apis.FieldError {
 	var errs *apis.FieldError
 	targetNamespacePath := fmt.Sprintf("%s.targetNamespace", path)
@@ -35,3 +42,21 @@ func (ta *CommonSpec) validate(path string) *apis.FieldError {
 	}
 	return errs
 }
+
+// isValidCronSchedule checks the schedule has the five fields of the standard cron format,
+// or is one of the predefined macros supported by CronJobs
+func isValidCronSchedule(schedule string) bool {
+	if strings.HasPrefix(schedule, "@") {
+		return isValueInArray(cronMacros, schedule)
+	}
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return false
+	}
+	for _, field := range fields {
+		if !cronFieldPattern.MatchString(field) {
+			return false
+		}
+	}
+	return true
+}
@@ -54,6 +54,12 @@ const (
 	DeploymentSpecHashValueLabelKey = "operator.tekton.dev/deployment-spec-applied-hash" // used to recreate pods, if there is a change detected in deployments spec
 	PreUpgradeVersionKey            = "operator.tekton.dev/pre-upgrade-version"          // used to monitor and execute pre upgrade functions
 	PostUpgradeVersionKey           = "operator.tekton.dev/post-upgrade-version"         // used to monitor and execute post upgrade functions
+	ManagedKey                      = "operator.tekton.dev/managed"                      // set to "false" on a live resource to stop the installer from updating it
+	MigratePrunerKey                = "operator.tekton.dev/migrate-pruner"               // set to "true" on TektonConfig to migrate the job based pruner to the event based pruner
+	PrunerMigrationReportKey        = "operator.tekton.dev/pruner-migration-report"      // settings of the job based pruner which could not be migrated
+	RestoreDBBackupKey              = "operator.tekton.dev/restore-db-backup"            // set to a backup name, or "latest", on TektonResult or TektonHub to restore the database
+	ProfileDefaultsKey              = "operator.tekton.dev/profile-defaults"             // defaults of the TektonProfile last merged in the TektonConfig
+	ProfileAppliedDefaultsKey       = "operator.tekton.dev/profile-applied-defaults"     // fields of the TektonConfig taken from the defaults of its TektonProfile
 
 	UpgradePending = "upgrade pending"
 	Reinstalling   = "reinstalling"
@@ -72,6 +78,25 @@ const (
 
 	// Maximum number of allowed buckets
 	MaxBuckets = 10
+
+	// Drift policies, decide what the installer does with the manual changes
+	// done on the resources it manages
+	DriftPolicyEnforce    = "Enforce"
+	DriftPolicyReportOnly = "ReportOnly"
+
+	// FieldManager is the field manager of the resources applied with server-side apply
+	FieldManager = "tekton-operator"
+
+	// Conflict policies, decide what the installer does when a field of a resource
+	// applied with server-side apply is owned by another field manager
+	ConflictPolicyForce  = "Force"
+	ConflictPolicyReport = "Report"
+
+	// Component states, reported in the TektonConfig status for each installed component
+	ComponentStateReady                  = "Ready"
+	ComponentStateNotReady               = "NotReady"
+	ComponentStateWaitingForDependencies = "WaitingForDependencies"
+	ComponentStateDisabled               = "Disabled"
 )
 
 var (
@@ -103,6 +128,16 @@ var (
 		ProfileAll,
 	}
 
+	DriftPolicies = []string{
+		DriftPolicyEnforce,
+		DriftPolicyReportOnly,
+	}
+
+	ConflictPolicies = []string{
+		ConflictPolicyForce,
+		ConflictPolicyReport,
+	}
+
 	PruningResource = []string{
 		"taskrun",
 		"pipelinerun",
@@ -134,6 +169,7 @@ const (
 	DashboardResourceName        = "dashboard"
 	OperandTektoncdDashboard     = "tektoncd-dashboard"
 	AddonResourceName            = "addon"
+	OperandTektoncdAddons        = "tektoncd-addons"
 	ResultResourceName           = "result"
 	OperandTektoncdResults       = "tektoncd-results"
 	HubResourceName              = "hub"
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DBBackupDefaultRetention is the number of backups kept on the target when retention is not set
+	DBBackupDefaultRetention = uint(7)
+	// DBBackupLatest can be used as value of the restore annotation to restore the last successful backup
+	DBBackupLatest = "latest"
+)
+
+// DBBackup configures scheduled backups of an operator managed PostgreSQL database.
+// Exactly one of PVC or S3 has to be set
+type DBBackup struct {
+	// Schedule of the backups in cron format
+	Schedule string `json:"schedule"`
+	// Retention is the number of backups kept on the target, older backups are deleted
+	// +optional
+	Retention *uint `json:"retention,omitempty"`
+	// PVC stores the backups on a PersistentVolumeClaim
+	// +optional
+	PVC *PVCBackupTarget `json:"pvc,omitempty"`
+	// S3 uploads the backups to an S3 compatible object storage
+	// +optional
+	S3 *S3BackupTarget `json:"s3,omitempty"`
+}
+
+// PVCBackupTarget stores the backups on an existing PersistentVolumeClaim of the target namespace
+type PVCBackupTarget struct {
+	ClaimName string `json:"claimName"`
+}
+
+// S3BackupTarget uploads the backups to a bucket of an S3 compatible object storage
+type S3BackupTarget struct {
+	// Endpoint is the URL of the object storage, e.g. https://s3.us-east-1.amazonaws.com
+	Endpoint string `json:"endpoint"`
+	Bucket   string `json:"bucket"`
+	// Prefix of the backup objects in the bucket
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// +optional
+	Region string `json:"region,omitempty"`
+	// SecretName is the name of a secret in the target namespace holding
+	// the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY keys
+	SecretName string `json:"secretName"`
+}
+
+// DBBackupStatus reports the last successful backup and the last restore of a database
+type DBBackupStatus struct {
+	// LastSuccessfulBackup is the name of the last successful backup,
+	// it can be used as value of the operator.tekton.dev/restore-db-backup annotation
+	// +optional
+	LastSuccessfulBackup string `json:"lastSuccessfulBackup,omitempty"`
+	// +optional
+	LastSuccessfulBackupTime *metav1.Time `json:"lastSuccessfulBackupTime,omitempty"`
+	// LastRestoredBackup is the name of the last backup restored
+	// +optional
+	LastRestoredBackup string `json:"lastRestoredBackup,omitempty"`
+	// +optional
+	LastRestoreTime *metav1.Time `json:"lastRestoreTime,omitempty"`
+}
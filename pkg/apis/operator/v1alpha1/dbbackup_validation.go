@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/url"
+
+	"knative.dev/pkg/apis"
+)
+
+func (b *DBBackup) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	if b == nil {
+		return errs
+	}
+
+	if b.Schedule == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".schedule"))
+	} else if !isValidCronSchedule(b.Schedule) {
+		errs = errs.Also(apis.ErrInvalidValue(b.Schedule, path+".schedule"))
+	}
+
+	if b.Retention != nil && *b.Retention == 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*b.Retention, path+".retention"))
+	}
+
+	switch {
+	case b.PVC == nil && b.S3 == nil:
+		errs = errs.Also(apis.ErrMissingOneOf(path+".pvc", path+".s3"))
+	case b.PVC != nil && b.S3 != nil:
+		errs = errs.Also(apis.ErrMultipleOneOf(path+".pvc", path+".s3"))
+	case b.PVC != nil:
+		if b.PVC.ClaimName == "" {
+			errs = errs.Also(apis.ErrMissingField(path + ".pvc.claimName"))
+		}
+	default:
+		errs = errs.Also(b.S3.validate(path + ".s3"))
+	}
+	return errs
+}
+
+func (s *S3BackupTarget) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	if s.Endpoint == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".endpoint"))
+	} else if endpoint, err := url.Parse(s.Endpoint); err != nil || endpoint.Host == "" ||
+		(endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		errs = errs.Also(apis.ErrInvalidValue(s.Endpoint, path+".endpoint", "endpoint must be an http or https URL"))
+	}
+	if s.Bucket == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".bucket"))
+	}
+	if s.SecretName == "" {
+		errs = errs.Also(apis.ErrMissingField(path + ".secretName"))
+	}
+	return errs
+}
+
+// validateDBBackup rejects backups of an external database, only the operator managed database can be backed up
+func (r *Result) validateDBBackup(path string) *apis.FieldError {
+	if r.DBBackup == nil {
+		return nil
+	}
+	if r.IsExternalDB {
+		return apis.ErrGeneric(fmt.Sprintf("backups are only supported for the operator managed database, %s.is_external_db must be false", path), path+".db_backup")
+	}
+	return r.DBBackup.validate(path + ".db_backup")
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDBBackupValidate(t *testing.T) {
+	zero := uint(0)
+	tests := []struct {
+		name   string
+		backup *DBBackup
+		err    string
+	}{
+		{
+			name:   "not set",
+			backup: nil,
+		},
+		{
+			name:   "pvc target",
+			backup: &DBBackup{Schedule: "0 2 * * *", PVC: &PVCBackupTarget{ClaimName: "backups"}},
+		},
+		{
+			name: "s3 target",
+			backup: &DBBackup{Schedule: "@daily", S3: &S3BackupTarget{
+				Endpoint: "http://minio.minio.svc:9000", Bucket: "backups", SecretName: "s3-credentials",
+			}},
+		},
+		{
+			name:   "invalid schedule",
+			backup: &DBBackup{Schedule: "every day", PVC: &PVCBackupTarget{ClaimName: "backups"}},
+			err:    "invalid value: every day: spec.db_backup.schedule",
+		},
+		{
+			name:   "missing schedule and zero retention",
+			backup: &DBBackup{Retention: &zero, PVC: &PVCBackupTarget{ClaimName: "backups"}},
+			err:    "invalid value: 0: spec.db_backup.retention\nmissing field(s): spec.db_backup.schedule",
+		},
+		{
+			name:   "missing target",
+			backup: &DBBackup{Schedule: "*/30 1-5 * * 1,3"},
+			err:    "expected exactly one, got neither: spec.db_backup.pvc, spec.db_backup.s3",
+		},
+		{
+			name: "multiple targets",
+			backup: &DBBackup{Schedule: "0 2 * * *", PVC: &PVCBackupTarget{ClaimName: "backups"},
+				S3: &S3BackupTarget{Endpoint: "https://s3.amazonaws.com", Bucket: "backups", SecretName: "s3-credentials"}},
+			err: "expected exactly one, got both: spec.db_backup.pvc, spec.db_backup.s3",
+		},
+		{
+			name:   "invalid s3 target",
+			backup: &DBBackup{Schedule: "0 2 * * *", S3: &S3BackupTarget{Endpoint: "minio:9000"}},
+			err:    "invalid value: minio:9000: spec.db_backup.s3.endpoint\nendpoint must be an http or https URL\nmissing field(s): spec.db_backup.s3.bucket, spec.db_backup.s3.secretName",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.backup.validate("spec.db_backup")
+			if test.err == "" {
+				assert.Assert(t, err == nil, "unexpected error: %v", err)
+				return
+			}
+			assert.Equal(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestTektonResult_ValidateDBBackupExternalDB(t *testing.T) {
+	tr := &TektonResult{
+		ObjectMeta: metav1.ObjectMeta{Name: ResultResourceName},
+		Spec: TektonResultSpec{
+			Result: Result{
+				ResultsAPIProperties: ResultsAPIProperties{IsExternalDB: true},
+				DBBackup:             &DBBackup{Schedule: "0 2 * * *", PVC: &PVCBackupTarget{ClaimName: "backups"}},
+			},
+		},
+	}
+
+	err := tr.Validate(context.TODO())
+	assert.Equal(t, err.Error(), "backups are only supported for the operator managed database, spec.is_external_db must be false: spec.db_backup")
+}
+
+func TestDBBackupSetDefaults(t *testing.T) {
+	th := &TektonHub{
+		Spec: TektonHubSpec{
+			Db: DbSpec{Backup: &DBBackup{Schedule: "0 2 * * *", PVC: &PVCBackupTarget{ClaimName: "backups"}}},
+		},
+	}
+	th.SetDefaults(context.TODO())
+	assert.Equal(t, *th.Spec.Db.Backup.Retention, DBBackupDefaultRetention)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// the Pod Security Standards levels, enforced by the Pod Security Admission
+	PodSecurityRestricted = "restricted"
+	PodSecurityBaseline   = "baseline"
+	PodSecurityPrivileged = "privileged"
+
+	// PodSecurityEnforceLabel is the namespace label of the level enforced by the Pod Security Admission
+	PodSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+	// PodSecurityWarnLabel is the namespace label of the level the Pod Security Admission warns about
+	PodSecurityWarnLabel = "pod-security.kubernetes.io/warn"
+	// PodSecurityDefaultAnnotation records the level labelled by the operator on a namespace, the
+	// levels labelled by the users are left as is
+	PodSecurityDefaultAnnotation = "operator.tekton.dev/pod-security-default"
+)
+
+// PodSecurityLevels are ordered from the most to the least restrictive
+var PodSecurityLevels = []string{PodSecurityRestricted, PodSecurityBaseline, PodSecurityPrivileged}
+
+type Kubernetes struct {
+	// PodSecurity allows configuring the Pod Security Admission levels of the pipeline namespaces
+	// +optional
+	PodSecurity *PodSecurity `json:"podSecurity,omitempty"`
+}
+
+type PodSecurity struct {
+	// Default is the level enforced on the selected namespaces without a level,
+	// defaults to restricted, the level the operands are deployed with, when
+	// NamespaceSelector is set
+	// +optional
+	Default string `json:"default,omitempty"`
+	// MaxAllowed specifies the least restrictive level that can be requested
+	// for in a namespace or in the Default field.
+	// +optional
+	MaxAllowed string `json:"maxAllowed,omitempty"`
+	// NamespaceSelector selects the pipeline namespaces labelled with the Default level,
+	// required with Default, the system namespaces and the target namespace are never labelled
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// PodSecurityAllowed returns true if the level is at least as restrictive as the maxAllowed level,
+// unknown levels are left to the Pod Security Admission
+func PodSecurityAllowed(level, maxAllowed string) bool {
+	levelIndex, maxIndex := podSecurityIndex(level), podSecurityIndex(maxAllowed)
+	if levelIndex < 0 || maxIndex < 0 {
+		return true
+	}
+	return levelIndex <= maxIndex
+}
+
+func podSecurityIndex(level string) int {
+	for i, l := range PodSecurityLevels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+const (
+	// MetricsBackendPrometheus exposes the metrics on the prometheus endpoint of the components
+	MetricsBackendPrometheus = "prometheus"
+	// MetricsBackendOTLP pushes the metrics to an OpenTelemetry collector
+	MetricsBackendOTLP = "otlp"
+	// MetricsBackendNone disables the metrics
+	MetricsBackendNone = "none"
+
+	// OTLPProtocolGRPC is the gRPC transport of the OpenTelemetry protocol
+	OTLPProtocolGRPC = "grpc"
+	// OTLPProtocolHTTP is the HTTP transport of the OpenTelemetry protocol
+	OTLPProtocolHTTP = "http/protobuf"
+)
+
+var (
+	MetricsBackends = []string{MetricsBackendPrometheus, MetricsBackendOTLP, MetricsBackendNone}
+	OTLPProtocols   = []string{OTLPProtocolGRPC, OTLPProtocolHTTP}
+)
+
+// Observability configures the metrics and the traces of the components and of the operator,
+// it is rendered into their observability ConfigMaps
+type Observability struct {
+	// +optional
+	Metrics *ObservabilityMetrics `json:"metrics,omitempty"`
+	// +optional
+	Tracing *ObservabilityTracing `json:"tracing,omitempty"`
+	// CredentialsSecret is the name of a secret of the target namespace, its headers key is
+	// sent as the OTLP headers by the components, eg. "Authorization=Bearer <token>"
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// ObservabilityMetrics configures the metrics backend
+type ObservabilityMetrics struct {
+	// Backend is one of prometheus, otlp and none
+	Backend string `json:"backend,omitempty"`
+	// Endpoint is the URL of the OpenTelemetry collector, required by the otlp backend
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// Protocol is the OTLP transport, grpc or http/protobuf, defaults to grpc
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// ObservabilityTracing configures the export of the traces to an OpenTelemetry collector
+type ObservabilityTracing struct {
+	// Endpoint is the URL of the OpenTelemetry collector
+	Endpoint string `json:"endpoint"`
+	// Protocol is the OTLP transport, grpc or http/protobuf, defaults to grpc
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// SamplingRate is the ratio of the traces sampled, between 0 and 1, defaults to 1
+	// +optional
+	SamplingRate *float64 `json:"samplingRate,omitempty"`
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"knative.dev/pkg/apis"
+)
+
+func (o *Observability) validate(path string) (errs *apis.FieldError) {
+	if o == nil {
+		return nil
+	}
+
+	if m := o.Metrics; m != nil {
+		if !isValueInArray(MetricsBackends, m.Backend) {
+			errs = errs.Also(apis.ErrInvalidValue(m.Backend, path+".metrics.backend"))
+		}
+		if m.Backend == MetricsBackendOTLP && m.Endpoint == "" {
+			errs = errs.Also(apis.ErrMissingField(path + ".metrics.endpoint"))
+		}
+		if m.Backend != MetricsBackendOTLP && m.Endpoint != "" {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("endpoint requires the %s backend", MetricsBackendOTLP), path+".metrics.endpoint"))
+		}
+		errs = errs.Also(validateOTLPProtocol(m.Protocol, path+".metrics.protocol"))
+	}
+
+	if t := o.Tracing; t != nil {
+		if t.Endpoint == "" {
+			errs = errs.Also(apis.ErrMissingField(path + ".tracing.endpoint"))
+		}
+		errs = errs.Also(validateOTLPProtocol(t.Protocol, path+".tracing.protocol"))
+		if t.SamplingRate != nil && (*t.SamplingRate < 0 || *t.SamplingRate > 1) {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*t.SamplingRate, 0, 1, path+".tracing.samplingRate"))
+		}
+	}
+	return errs
+}
+
+func validateOTLPProtocol(protocol, path string) *apis.FieldError {
+	if protocol != "" && !isValueInArray(OTLPProtocols, protocol) {
+		return apis.ErrInvalidValue(protocol, path)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/ptr"
+)
+
+func TestObservabilityValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		observability *Observability
+		err           string
+	}{
+		{
+			name: "no config",
+		},
+		{
+			name: "prometheus and tracing",
+			observability: &Observability{
+				Metrics: &ObservabilityMetrics{Backend: MetricsBackendPrometheus},
+				Tracing: &ObservabilityTracing{Endpoint: "http://otel-collector:4317", SamplingRate: ptr.Float64(0.1)},
+			},
+		},
+		{
+			name: "otlp",
+			observability: &Observability{
+				Metrics: &ObservabilityMetrics{Backend: MetricsBackendOTLP, Endpoint: "http://otel-collector:4318", Protocol: OTLPProtocolHTTP},
+			},
+		},
+		{
+			name: "invalid backend",
+			observability: &Observability{
+				Metrics: &ObservabilityMetrics{Backend: "opencensus"},
+			},
+			err: "invalid value: opencensus: spec.config.observability.metrics.backend",
+		},
+		{
+			name: "otlp without endpoint",
+			observability: &Observability{
+				Metrics: &ObservabilityMetrics{Backend: MetricsBackendOTLP, Protocol: "udp"},
+			},
+			err: "invalid value: udp: spec.config.observability.metrics.protocol\nmissing field(s): spec.config.observability.metrics.endpoint",
+		},
+		{
+			name: "endpoint without otlp",
+			observability: &Observability{
+				Metrics: &ObservabilityMetrics{Backend: MetricsBackendPrometheus, Endpoint: "http://otel-collector:4318"},
+			},
+			err: "endpoint requires the otlp backend: spec.config.observability.metrics.endpoint",
+		},
+		{
+			name: "invalid tracing",
+			observability: &Observability{
+				Tracing: &ObservabilityTracing{SamplingRate: ptr.Float64(2)},
+			},
+			err: "expected 0 <= 2 <= 1: spec.config.observability.tracing.samplingRate\nmissing field(s): spec.config.observability.tracing.endpoint",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := test.observability.validate("spec.config.observability")
+			assert.Equal(t, test.err, errs.Error())
+		})
+	}
+}
+
+func TestComponentConfigsValidate(t *testing.T) {
+	cc := ComponentConfigs{
+		Pipeline: &Config{},
+		Result: &Config{Observability: &Observability{
+			Metrics: &ObservabilityMetrics{Backend: "statsd"},
+		}},
+	}
+	errs := cc.validate("spec.componentConfig")
+	assert.Equal(t, "invalid value: statsd: spec.componentConfig.result.observability.metrics.backend", errs.Error())
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// PipelineFeatureFlagsConfigMap is the ConfigMap of the pipeline feature flags
+	PipelineFeatureFlagsConfigMap = "feature-flags"
+	// PipelineConfigDefaultsConfigMap is the ConfigMap of the pipeline defaults
+	PipelineConfigDefaultsConfigMap = "config-defaults"
+)
+
+// PipelineParams are the params of the Pipeline component
+var PipelineParams = map[string]ParamValue{
+	enableMetricsKey: {Default: enableMetricsDefaultValue, Possible: []string{"true", "false"}},
+}
+
+// ConfigValueKind is the kind of the value of a ConfigMap key, inferred from its default
+type ConfigValueKind string
+
+const (
+	ConfigValueBool   ConfigValueKind = "bool"
+	ConfigValueInt    ConfigValueKind = "int"
+	ConfigValueString ConfigValueKind = "string"
+)
+
+// PipelineConfigKeys are the keys of the pipeline ConfigMaps, by ConfigMap, as bundled with
+// the Pipelines release installed by the operator
+type PipelineConfigKeys map[string]map[string]ConfigValueKind
+
+type pipelineConfigKeysKey struct{}
+
+// WithPipelineConfigKeys makes the validation check the pipeline config against the keys
+// of the installed Pipelines release
+func WithPipelineConfigKeys(ctx context.Context, keys PipelineConfigKeys) context.Context {
+	return context.WithValue(ctx, pipelineConfigKeysKey{}, keys)
+}
+
+func pipelineConfigKeysFrom(ctx context.Context) PipelineConfigKeys {
+	keys, _ := ctx.Value(pipelineConfigKeysKey{}).(PipelineConfigKeys)
+	return keys
+}
+
+// ConfigValueKindOf returns the kind of a default value
+func ConfigValueKindOf(value string) ConfigValueKind {
+	if value == "true" || value == "false" {
+		return ConfigValueBool
+	}
+	if _, err := strconv.Atoi(value); err == nil {
+		return ConfigValueInt
+	}
+	return ConfigValueString
+}
+
+func (k ConfigValueKind) accepts(value string) bool {
+	switch k {
+	case ConfigValueBool:
+		_, err := strconv.ParseBool(value)
+		return err == nil
+	case ConfigValueInt:
+		_, err := strconv.Atoi(value)
+		return err == nil
+	}
+	return true
+}
+
+// ValidateConfig validates the params and the overrides of the pipeline ConfigMaps against the
+// keys of the installed Pipelines release. On update, the settings already stored are reported
+// as warnings, not to block the updates of an existing resource
+func (p *Pipeline) ValidateConfig(ctx context.Context, baseline *Pipeline, path string) (errs *apis.FieldError) {
+	if baseline == nil {
+		baseline = &Pipeline{}
+	}
+	stored := ParseParams(baseline.Params)
+	for i, param := range p.Params {
+		_, existing := stored[param.Name]
+		paramValue, ok := PipelineParams[param.Name]
+		if !ok {
+			errs = errs.Also(levelOf(apis.ErrInvalidKeyName(param.Name, path+".params"), existing))
+			continue
+		}
+		if !isValueInArray(paramValue.Possible, param.Value) {
+			errs = errs.Also(levelOf(apis.ErrInvalidArrayValue(param.Value, path+".params."+param.Name, i), existing && stored[param.Name] == param.Value))
+		}
+	}
+
+	keys := pipelineConfigKeysFrom(ctx)
+	if keys == nil {
+		return errs
+	}
+
+	for _, name := range []string{PipelineFeatureFlagsConfigMap, PipelineConfigDefaultsConfigMap} {
+		data := p.Options.ConfigMaps[name].Data
+		storedData := baseline.Options.ConfigMaps[name].Data
+		dataPath := fmt.Sprintf("%s.options.configMaps.%s.data", path, name)
+		for _, key := range sortedKeys(data) {
+			storedValue, existing := storedData[key]
+			kind, ok := keys[name][key]
+			if !ok {
+				err := apis.ErrInvalidKeyName(key, dataPath, fmt.Sprintf("not a key of the %s ConfigMap of the installed Pipelines release", name))
+				errs = errs.Also(levelOf(err, existing))
+				continue
+			}
+			if !kind.accepts(data[key]) {
+				err := apis.ErrInvalidValue(data[key], dataPath+"."+key, fmt.Sprintf("expected a %s", kind))
+				errs = errs.Also(levelOf(err, existing && storedValue == data[key]))
+			}
+		}
+	}
+
+	// the typed fields are kept for the API compatibility once removed from the release,
+	// the fields left to the defaults of the operator are not reported
+	defaults := &Pipeline{}
+	defaults.setDefaults()
+	for _, field := range []struct {
+		configMap  string
+		properties interface{}
+		defaults   interface{}
+		path       string
+	}{
+		{PipelineFeatureFlagsConfigMap, p.PipelineProperties, defaults.PipelineProperties, path},
+		{PipelineConfigDefaultsConfigMap, p.OptionalPipelineProperties, defaults.OptionalPipelineProperties, path},
+	} {
+		for _, key := range setKeys(field.properties, field.defaults) {
+			if _, ok := keys[field.configMap][key]; !ok {
+				errs = errs.Also(apis.ErrGeneric(
+					fmt.Sprintf("%s is deprecated or removed in the installed Pipelines release, it has no effect", key),
+					field.path+"."+key,
+				).At(apis.WarningLevel))
+			}
+		}
+	}
+	return errs
+}
+
+// levelOf reports an error of a setting already stored as a warning
+func levelOf(err *apis.FieldError, stored bool) *apis.FieldError {
+	if stored {
+		return err.At(apis.WarningLevel)
+	}
+	return err
+}
+
+// setKeys returns the json keys of the scalar fields set in the properties to another value
+// than the defaults, the keys written to the ConfigMap by common.AddConfigMapValues
+func setKeys(properties, defaults interface{}) []string {
+	values := reflect.ValueOf(properties)
+	defaultValues := reflect.ValueOf(defaults)
+	var keys []string
+	for index := 0; index < values.NumField(); index++ {
+		key := strings.Split(values.Type().Field(index).Tag.Get("json"), ",")[0]
+		element := values.Field(index)
+		if key == "" || element.IsZero() || reflect.DeepEqual(element.Interface(), defaultValues.Field(index).Interface()) {
+			continue
+		}
+		if element.Kind() == reflect.Ptr {
+			element = element.Elem()
+		}
+		if element.Kind() == reflect.Struct || element.Kind() == reflect.Map {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
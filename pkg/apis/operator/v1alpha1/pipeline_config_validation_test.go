@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+var testPipelineConfigKeys = PipelineConfigKeys{
+	PipelineFeatureFlagsConfigMap: {
+		"disable-creds-init": ConfigValueBool,
+		"enable-api-fields":  ConfigValueString,
+		"max-result-size":    ConfigValueInt,
+	},
+	PipelineConfigDefaultsConfigMap: {
+		"default-timeout-minutes": ConfigValueInt,
+		"default-service-account": ConfigValueString,
+	},
+}
+
+func pipelineWithConfig(params []Param, featureFlags map[string]string) *Pipeline {
+	p := &Pipeline{Params: params}
+	if featureFlags != nil {
+		p.Options.ConfigMaps = map[string]corev1.ConfigMap{
+			PipelineFeatureFlagsConfigMap: {Data: featureFlags},
+		}
+	}
+	return p
+}
+
+func TestPipelineValidateConfig(t *testing.T) {
+	ctx := WithPipelineConfigKeys(context.Background(), testPipelineConfigKeys)
+
+	tests := []struct {
+		name     string
+		pipeline *Pipeline
+		baseline *Pipeline
+		err      string
+		warning  string
+	}{
+		{
+			name:     "valid",
+			pipeline: pipelineWithConfig([]Param{{Name: "enableMetrics", Value: "false"}}, map[string]string{"disable-creds-init": "true", "max-result-size": "8192"}),
+		},
+		{
+			name:     "unknown param",
+			pipeline: pipelineWithConfig([]Param{{Name: "enableMetric", Value: "true"}}, nil),
+			err:      `invalid key name "enableMetric": spec.params`,
+		},
+		{
+			name:     "invalid param value",
+			pipeline: pipelineWithConfig([]Param{{Name: "enableMetrics", Value: "yes"}}, nil),
+			err:      "invalid value: yes: spec.params.enableMetrics[0]",
+		},
+		{
+			name:     "unknown feature flag",
+			pipeline: pipelineWithConfig(nil, map[string]string{"enable-custom-tasks": "true"}),
+			err:      `invalid key name "enable-custom-tasks": spec.options.configMaps.feature-flags.data` + "\nnot a key of the feature-flags ConfigMap of the installed Pipelines release",
+		},
+		{
+			name:     "invalid feature flag value",
+			pipeline: pipelineWithConfig(nil, map[string]string{"disable-creds-init": "yes"}),
+			err:      "invalid value: yes: spec.options.configMaps.feature-flags.data.disable-creds-init\nexpected a bool",
+		},
+		{
+			name:     "stored unknown feature flag",
+			pipeline: pipelineWithConfig(nil, map[string]string{"enable-custom-tasks": "true", "max-result-size": "8192"}),
+			baseline: pipelineWithConfig(nil, map[string]string{"enable-custom-tasks": "true"}),
+			warning:  `invalid key name "enable-custom-tasks": spec.options.configMaps.feature-flags.data` + "\nnot a key of the feature-flags ConfigMap of the installed Pipelines release",
+		},
+		{
+			name:     "changed invalid feature flag value",
+			pipeline: pipelineWithConfig(nil, map[string]string{"max-result-size": "large"}),
+			baseline: pipelineWithConfig(nil, map[string]string{"max-result-size": "big"}),
+			err:      "invalid value: large: spec.options.configMaps.feature-flags.data.max-result-size\nexpected a int",
+		},
+		{
+			name: "removed typed field left to the default",
+			pipeline: &Pipeline{PipelineProperties: PipelineProperties{
+				EnableCustomTasks: ptr.Bool(true),
+			}},
+		},
+		{
+			name: "removed typed field",
+			pipeline: &Pipeline{PipelineProperties: PipelineProperties{
+				EnableCustomTasks: ptr.Bool(false),
+				DisableCredsInit:  ptr.Bool(true),
+			}},
+			warning: "enable-custom-tasks is deprecated or removed in the installed Pipelines release, it has no effect: spec.enable-custom-tasks",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := test.pipeline.ValidateConfig(ctx, test.baseline, "spec")
+			assert.Equal(t, test.err, errs.Filter(apis.ErrorLevel).Error())
+			assert.Equal(t, test.warning, errs.Filter(apis.WarningLevel).Error())
+		})
+	}
+}
+
+func TestPipelineValidateConfig_WithoutKeys(t *testing.T) {
+	p := pipelineWithConfig([]Param{{Name: "enableMetrics", Value: "true"}}, map[string]string{"unknown": "value"})
+	p.EnableCustomTasks = ptr.Bool(false)
+
+	errs := p.ValidateConfig(context.Background(), nil, "spec")
+	assert.Equal(t, "", errs.Error())
+}
@@ -64,6 +64,9 @@ const (
 
 	// KindTektonPruner is the Kind of TektonPruner in a GVK context.
 	KindTektonPruner = "TektonPruner"
+
+	// KindTektonProfile is the Kind of TektonProfile in a GVK context.
+	KindTektonProfile = "TektonProfile"
 )
 
 // Resource takes an unqualified resource and returns a Group qualified GroupResource
@@ -99,6 +102,8 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&ManualApprovalGateList{},
 		&TektonPruner{},
 		&TektonPrunerList{},
+		&TektonProfile{},
+		&TektonProfileList{},
 	)
 	metav1.AddToGroupVersion(s, SchemeGroupVersion)
 	return nil
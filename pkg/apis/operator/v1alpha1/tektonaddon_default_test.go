@@ -106,3 +106,14 @@ func checkAddonParams(t *testing.T, actualParams []Param, expectedParams map[str
 		assert.Equal(t, expectedValue, value, "Param %q has incorrect value", key)
 	}
 }
+
+func Test_AddonSetDefaults_CommunityResolverTasks(t *testing.T) {
+	addon := &Addon{}
+	setAddonDefaults(addon)
+	assert.Equal(t, ParseParams(addon.Params)[CommunityResolverTasks], "false")
+
+	t.Setenv("PLATFORM", "openshift")
+	addon = &Addon{}
+	setAddonDefaults(addon)
+	assert.Equal(t, ParseParams(addon.Params)[CommunityResolverTasks], "true")
+}
@@ -44,10 +44,16 @@ func setAddonDefaults(addon *Addon) {
 	for d := range AddonParams {
 		_, ok := paramsMap[d]
 		if !ok {
+			value := AddonParams[d].Default
+			// the community tasks are fetched from GitHub, on Kubernetes they are installed
+			// only on demand to keep the disconnected clusters working
+			if d == CommunityResolverTasks && !IsOpenShiftPlatform() {
+				value = "false"
+			}
 			addon.Params = append(addon.Params,
 				Param{
 					Name:  d,
-					Value: AddonParams[d].Default,
+					Value: value,
 				})
 		}
 	}
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"net/url"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -80,10 +82,113 @@ type Addon struct {
 	// EnablePAC field defines whether to install PAC
 	// +optional
 	EnablePAC *bool `json:"enablePipelinesAsCode,omitempty"`
+	// Catalogs is the list of user defined catalogs whose Tasks and StepActions
+	// are installed in the target namespace
+	// +optional
+	Catalogs []AddonCatalog `json:"catalogs,omitempty"`
 }
 
 func (a Addon) IsEmpty() bool {
-	return len(a.Params) == 0
+	return len(a.Params) == 0 && len(a.Catalogs) == 0
+}
+
+// AddonCatalog defines a source of Tasks and StepActions installed by the Addon.
+// Exactly one of the sources has to be set
+type AddonCatalog struct {
+	// Name of the catalog, each catalog is installed with its own TektonInstallerSet
+	Name string `json:"name"`
+	// Git fetches the resources from files of a git repository
+	// +optional
+	Git *GitCatalogSource `json:"git,omitempty"`
+	// Bundle fetches the resources from a Tekton OCI bundle
+	// +optional
+	Bundle *BundleCatalogSource `json:"bundle,omitempty"`
+	// ConfigMap reads the resources from the data of a ConfigMap
+	// +optional
+	ConfigMap *ConfigMapCatalogSource `json:"configMap,omitempty"`
+	// URL fetches the resources from a URL, verified with a checksum
+	// +optional
+	URL *URLCatalogSource `json:"url,omitempty"`
+	// Filters selects the resources of the catalog to install,
+	// all the Tasks and StepActions are installed if empty
+	// +optional
+	Filters []CatalogFilter `json:"filters,omitempty"`
+	// RefreshInterval is the interval at which the catalog is fetched again,
+	// if not set the catalog is fetched only when the spec changes
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+const (
+	GitProviderGitHub = "github"
+	GitProviderGitLab = "gitlab"
+)
+
+// GitCatalogSource refers to files of a git repository hosted on GitHub or GitLab
+type GitCatalogSource struct {
+	// URL of the repository, for example https://github.com/tektoncd/catalog
+	URL string `json:"url"`
+	// Revision is the branch, tag or commit to fetch the files from
+	// +optional
+	Revision string `json:"revision,omitempty"`
+	// Paths of the files in the repository
+	Paths []string `json:"paths"`
+	// Provider is the service hosting the repository, github or gitlab. It is required for
+	// the hosts other than github.com and gitlab.com, like GitHub Enterprise or a self-hosted GitLab
+	// +optional
+	Provider string `json:"provider,omitempty"`
+}
+
+// GetProvider returns the service hosting the repository, derived from the host of the url
+// if not set, or an empty string if it is unknown
+func (g *GitCatalogSource) GetProvider() string {
+	if g.Provider != "" {
+		return g.Provider
+	}
+	u, err := url.Parse(g.URL)
+	if err != nil {
+		return ""
+	}
+	switch u.Host {
+	case "github.com":
+		return GitProviderGitHub
+	case "gitlab.com":
+		return GitProviderGitLab
+	}
+	return ""
+}
+
+// BundleCatalogSource refers to a Tekton OCI bundle
+type BundleCatalogSource struct {
+	// Image reference of the bundle, preferably by digest
+	Image string `json:"image"`
+}
+
+// ConfigMapCatalogSource refers to a ConfigMap holding a resource in each data key
+type ConfigMapCatalogSource struct {
+	// Name of the ConfigMap
+	Name string `json:"name"`
+	// Namespace of the ConfigMap, defaults to the target namespace
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// URLCatalogSource refers to a file served over http(s)
+type URLCatalogSource struct {
+	// URL of the file
+	URL string `json:"url"`
+	// Checksum of the file in the form sha256:<hex>
+	Checksum string `json:"checksum"`
+}
+
+// CatalogFilter selects resources of a catalog by name and version.
+// Names and versions are glob patterns, the version is read from the
+// app.kubernetes.io/version label of the resource
+type CatalogFilter struct {
+	// +optional
+	Name string `json:"name,omitempty"`
+	// +optional
+	Version string `json:"version,omitempty"`
 }
 
 // TektonAddonsList contains a list of TektonAddon
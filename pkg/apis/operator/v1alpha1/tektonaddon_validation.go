@@ -19,10 +19,16 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path"
+	"regexp"
 
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 )
 
+var catalogChecksumRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
 func (ta *TektonAddon) Validate(ctx context.Context) (errs *apis.FieldError) {
 
 	if apis.IsInDelete(ctx) {
@@ -41,6 +47,8 @@ func (ta *TektonAddon) Validate(ctx context.Context) (errs *apis.FieldError) {
 		errs = errs.Also(validateAddonParams(ta.Spec.Params, "spec.params"))
 	}
 
+	errs = errs.Also(validateAddonCatalogs(ta.Spec.Catalogs, "spec.catalogs"))
+
 	return errs
 }
 
@@ -70,3 +78,82 @@ func validateAddonParams(params []Param, pathToParams string) *apis.FieldError {
 
 	return errs
 }
+
+func validateAddonCatalogs(catalogs []AddonCatalog, pathToCatalogs string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	names := map[string]bool{}
+	for i, catalog := range catalogs {
+		catalogPath := fmt.Sprintf("%s[%d]", pathToCatalogs, i)
+
+		// the name is part of the installer set type label
+		if msgs := validation.IsDNS1123Label(catalog.Name); len(msgs) != 0 {
+			errs = errs.Also(apis.ErrInvalidValue(catalog.Name, catalogPath+".name", msgs...))
+		} else if names[catalog.Name] {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("duplicate catalog name %q", catalog.Name), catalogPath+".name"))
+		}
+		names[catalog.Name] = true
+
+		sources := []string{}
+		if catalog.Git != nil {
+			sources = append(sources, "git")
+			if _, err := url.ParseRequestURI(catalog.Git.URL); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(catalog.Git.URL, catalogPath+".git.url"))
+			}
+			if len(catalog.Git.Paths) == 0 {
+				errs = errs.Also(apis.ErrMissingField(catalogPath + ".git.paths"))
+			}
+			// the raw content of the files is served differently by each provider
+			switch catalog.Git.GetProvider() {
+			case GitProviderGitHub, GitProviderGitLab:
+			case "":
+				errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("provider of %s is not known, set %s or %s", catalog.Git.URL, GitProviderGitHub, GitProviderGitLab), catalogPath+".git.provider"))
+			default:
+				errs = errs.Also(apis.ErrInvalidValue(catalog.Git.Provider, catalogPath+".git.provider", fmt.Sprintf("supported providers are %s and %s", GitProviderGitHub, GitProviderGitLab)))
+			}
+		}
+		if catalog.Bundle != nil {
+			sources = append(sources, "bundle")
+			if catalog.Bundle.Image == "" {
+				errs = errs.Also(apis.ErrMissingField(catalogPath + ".bundle.image"))
+			}
+		}
+		if catalog.ConfigMap != nil {
+			sources = append(sources, "configMap")
+			if catalog.ConfigMap.Name == "" {
+				errs = errs.Also(apis.ErrMissingField(catalogPath + ".configMap.name"))
+			}
+		}
+		if catalog.URL != nil {
+			sources = append(sources, "url")
+			if _, err := url.ParseRequestURI(catalog.URL.URL); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(catalog.URL.URL, catalogPath+".url.url"))
+			}
+			if !catalogChecksumRegex.MatchString(catalog.URL.Checksum) {
+				errs = errs.Also(apis.ErrInvalidValue(catalog.URL.Checksum, catalogPath+".url.checksum", "expected format sha256:<hex>"))
+			}
+		}
+		switch len(sources) {
+		case 0:
+			errs = errs.Also(apis.ErrMissingOneOf(catalogPath+".git", catalogPath+".bundle", catalogPath+".configMap", catalogPath+".url"))
+		case 1:
+		default:
+			errs = errs.Also(apis.ErrMultipleOneOf(sources...).ViaField(catalogPath))
+		}
+
+		for j, filter := range catalog.Filters {
+			filterPath := fmt.Sprintf("%s.filters[%d]", catalogPath, j)
+			if _, err := path.Match(filter.Name, ""); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(filter.Name, filterPath+".name"))
+			}
+			if _, err := path.Match(filter.Version, ""); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(filter.Version, filterPath+".version"))
+			}
+		}
+
+		if catalog.RefreshInterval != nil && catalog.RefreshInterval.Duration <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(catalog.RefreshInterval.Duration.String(), catalogPath+".refreshInterval"))
+		}
+	}
+	return errs
+}
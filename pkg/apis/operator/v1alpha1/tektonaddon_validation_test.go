@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"gotest.tools/v3/assert"
@@ -126,3 +127,82 @@ func Test_ValidateTektonAddon_ResolverTaskIsFalseAndPipelineTemplateIsTrue(t *te
 	err := ta.Validate(context.TODO())
 	assert.Equal(t, "pipelineTemplates cannot be true if resolverTask is false: spec.params", err.Error())
 }
+
+func Test_ValidateTektonAddon_Catalogs(t *testing.T) {
+	tests := []struct {
+		name     string
+		catalogs []AddonCatalog
+		err      string
+	}{
+		{
+			name: "valid catalogs",
+			catalogs: []AddonCatalog{
+				{Name: "git", Git: &GitCatalogSource{URL: "https://github.com/tektoncd/catalog", Paths: []string{"task/git-clone/0.9/git-clone.yaml"}}},
+				{Name: "bundle", Bundle: &BundleCatalogSource{Image: "registry.example.com/catalog:v1"}, Filters: []CatalogFilter{{Name: "git-*", Version: "0.9"}}},
+				{Name: "config-map", ConfigMap: &ConfigMapCatalogSource{Name: "tasks"}},
+				{Name: "url", URL: &URLCatalogSource{URL: "https://example.com/task.yaml", Checksum: "sha256:" + strings.Repeat("a", 64)}},
+			},
+		},
+		{
+			name:     "missing source",
+			catalogs: []AddonCatalog{{Name: "empty"}},
+			err:      "expected exactly one, got neither: spec.catalogs[0].bundle, spec.catalogs[0].configMap, spec.catalogs[0].git, spec.catalogs[0].url",
+		},
+		{
+			name: "multiple sources",
+			catalogs: []AddonCatalog{{
+				Name:      "multiple",
+				Bundle:    &BundleCatalogSource{Image: "registry.example.com/catalog:v1"},
+				ConfigMap: &ConfigMapCatalogSource{Name: "tasks"},
+			}},
+			err: "expected exactly one, got both: spec.catalogs[0].bundle, spec.catalogs[0].configMap",
+		},
+		{
+			name: "duplicate name",
+			catalogs: []AddonCatalog{
+				{Name: "tasks", ConfigMap: &ConfigMapCatalogSource{Name: "tasks"}},
+				{Name: "tasks", ConfigMap: &ConfigMapCatalogSource{Name: "other-tasks"}},
+			},
+			err: "duplicate catalog name \"tasks\": spec.catalogs[1].name",
+		},
+		{
+			name: "git provider set for a self-hosted instance",
+			catalogs: []AddonCatalog{
+				{Name: "git", Git: &GitCatalogSource{URL: "https://git.example.com/team/catalog", Provider: GitProviderGitLab, Paths: []string{"tasks.yaml"}}},
+			},
+		},
+		{
+			name:     "git provider not known",
+			catalogs: []AddonCatalog{{Name: "git", Git: &GitCatalogSource{URL: "https://gitea.example.com/team/catalog", Paths: []string{"tasks.yaml"}}}},
+			err:      "provider of https://gitea.example.com/team/catalog is not known, set github or gitlab: spec.catalogs[0].git.provider",
+		},
+		{
+			name:     "git provider not supported",
+			catalogs: []AddonCatalog{{Name: "git", Git: &GitCatalogSource{URL: "https://gitea.example.com/team/catalog", Provider: "gitea", Paths: []string{"tasks.yaml"}}}},
+			err:      "invalid value: gitea: spec.catalogs[0].git.provider\nsupported providers are github and gitlab",
+		},
+		{
+			name:     "invalid checksum",
+			catalogs: []AddonCatalog{{Name: "url", URL: &URLCatalogSource{URL: "https://example.com/task.yaml", Checksum: "md5:abc"}}},
+			err:      "invalid value: md5:abc: spec.catalogs[0].url.checksum\nexpected format sha256:<hex>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ta := &TektonAddon{
+				ObjectMeta: metav1.ObjectMeta{Name: "addon"},
+				Spec: TektonAddonSpec{
+					CommonSpec: CommonSpec{TargetNamespace: "namespace"},
+					Addon:      Addon{Catalogs: test.catalogs},
+				},
+			}
+			err := ta.Validate(context.TODO())
+			if test.err == "" {
+				assert.Assert(t, err == nil, "unexpected error: %v", err)
+				return
+			}
+			assert.Equal(t, test.err, err.Error())
+		})
+	}
+}
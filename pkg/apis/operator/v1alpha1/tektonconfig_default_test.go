@@ -243,3 +243,49 @@ func Test_SetDefaults_SCC(t *testing.T) {
 		})
 	}
 }
+
+func Test_SetDefaults_PodSecurity(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *PodSecurity
+		expected *PodSecurity
+	}{
+		{
+			name: "no defaulting when podSecurity is not set",
+		},
+		{
+			name:     "default level is set to 'restricted' when empty",
+			input:    &PodSecurity{MaxAllowed: PodSecurityBaseline, NamespaceSelector: &metav1.LabelSelector{}},
+			expected: &PodSecurity{Default: PodSecurityRestricted, MaxAllowed: PodSecurityBaseline, NamespaceSelector: &metav1.LabelSelector{}},
+		},
+		{
+			name:     "no defaulting without namespaceSelector",
+			input:    &PodSecurity{MaxAllowed: PodSecurityBaseline},
+			expected: &PodSecurity{MaxAllowed: PodSecurityBaseline},
+		},
+		{
+			name:     "no defaulting when default is set",
+			input:    &PodSecurity{Default: PodSecurityBaseline},
+			expected: &PodSecurity{Default: PodSecurityBaseline},
+		},
+	}
+
+	for _, test := range tests {
+		tektonConfig := TektonConfig{
+			Spec: TektonConfigSpec{
+				Platforms: Platforms{
+					Kubernetes: Kubernetes{
+						PodSecurity: test.input,
+					},
+				},
+			},
+		}
+
+		tektonConfig.SetDefaults(context.TODO())
+		t.Run(test.name, func(t *testing.T) {
+			if !cmp.Equal(tektonConfig.Spec.Platforms.Kubernetes.PodSecurity, test.expected) {
+				t.Errorf("expected tektonconfig %#v, got %#v", test.expected, tektonConfig.Spec.Platforms.Kubernetes.PodSecurity)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"strings"
 
+	"knative.dev/pkg/apis"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/ptr"
 )
@@ -28,6 +29,7 @@ func (tc *TektonConfig) SetDefaults(ctx context.Context) {
 	if tc.Spec.Profile == "" {
 		tc.Spec.Profile = ProfileBasic
 	}
+	tc.setProfileDefaults(ctx)
 	tc.Spec.Pipeline.setDefaults()
 	tc.Spec.Trigger.setDefaults()
 	tc.Spec.Chain.setDefaults()
@@ -66,11 +68,17 @@ func (tc *TektonConfig) SetDefaults(ctx context.Context) {
 			tc.Spec.Platforms.OpenShift.SCC.Default = PipelinesSCC
 		}
 
-		setAddonDefaults(&tc.Spec.Addon)
+		tc.Spec.Platforms.Kubernetes = Kubernetes{}
 	} else {
-		tc.Spec.Addon = Addon{}
 		tc.Spec.Platforms.OpenShift = OpenShift{}
+
+		// the operands are deployed with the restricted security context, the selected
+		// namespaces are labelled with the same level
+		if ps := tc.Spec.Platforms.Kubernetes.PodSecurity; ps != nil && ps.Default == "" && ps.NamespaceSelector != nil {
+			ps.Default = PodSecurityRestricted
+		}
 	}
+	setAddonDefaults(&tc.Spec.Addon)
 
 	// earlier pruner was disabled with empty schedule or empty resources
 	// now empty schedule, disables only the global cron job,
@@ -97,3 +105,30 @@ func (tc *TektonConfig) SetDefaults(ctx context.Context) {
 		}
 	}
 }
+
+// setProfileDefaults merges the defaults of the TektonProfile referenced by spec.profile
+// under the spec, a missing profile is reported by the validation
+func (tc *TektonConfig) setProfileDefaults(ctx context.Context) {
+	// the old object of an update is defaulted as well, its defaults are already merged
+	if !apis.IsInCreate(ctx) && !apis.IsInUpdate(ctx) {
+		return
+	}
+	getter := profileGetterFrom(ctx)
+	if getter == nil {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	// a builtin profile has no defaults, the ones of a previous profile are removed
+	profile := TektonProfileSpec{}
+	if !IsBuiltinProfile(tc.Spec.Profile) {
+		tp, err := getter(ctx, tc.Spec.Profile)
+		if err != nil {
+			logger.Warnw("failed to get the TektonProfile", "profile", tc.Spec.Profile, "error", err)
+			return
+		}
+		profile = tp.Spec
+	}
+	if err := tc.mergeProfileDefaults(ctx, profile); err != nil {
+		logger.Warnw("failed to merge the TektonProfile defaults", "profile", tc.Spec.Profile, "error", err)
+	}
+}
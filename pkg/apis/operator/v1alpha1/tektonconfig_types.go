@@ -82,10 +82,15 @@ type NamespaceMetadata struct {
 
 // TektonConfigSpec defines the desired state of TektonConfig
 type TektonConfigSpec struct {
+	// Profile is one of lite, basic and all, or the name of a TektonProfile
 	Profile string `json:"profile,omitempty"`
 	// Config holds the configuration for resources created by TektonConfig
 	// +optional
 	Config Config `json:"config,omitempty"`
+	// ComponentConfig overrides the config for the resources of a component, a field
+	// set for a component replaces the one of the config
+	// +optional
+	ComponentConfig ComponentConfigs `json:"componentConfig,omitempty"`
 	// Pruner holds the prune config
 	// +optional
 	Pruner Prune `json:"pruner,omitempty"`
@@ -122,6 +127,62 @@ type TektonConfigSpec struct {
 	// holds target namespace metadata
 	// +optional
 	TargetNamespaceMetadata *NamespaceMetadata `json:"targetNamespaceMetadata,omitempty"`
+	// UnmanagedResources lists the resources the installer should leave alone
+	// once they exist on the cluster
+	// +optional
+	UnmanagedResources []ResourceSelector `json:"unmanagedResources,omitempty"`
+	// DriftPolicy decides if the manual changes done on the installed resources
+	// are reverted (Enforce) or only reported (ReportOnly), defaults to Enforce
+	// +optional
+	DriftPolicy string `json:"driftPolicy,omitempty"`
+	// ServerSideApply moves the installer to server-side apply, the fields of the
+	// installed resources owned by other field managers are kept
+	// +optional
+	ServerSideApply *ServerSideApply `json:"serverSideApply,omitempty"`
+	// Monitoring generates the ServiceMonitors and the PrometheusRules of the components,
+	// on Kubernetes only, OpenShift ships its own
+	// +optional
+	Monitoring *Monitoring `json:"monitoring,omitempty"`
+}
+
+// ServerSideApply configures the server-side apply of the installed resources
+type ServerSideApply struct {
+	// ConflictPolicy decides what the installer does when a field it applies is owned by
+	// another field manager, Force (default) takes the field over, Report leaves the
+	// resource unchanged and reports the conflict on the TektonInstallerSet
+	// +optional
+	ConflictPolicy string `json:"conflictPolicy,omitempty"`
+}
+
+// Monitoring configures the prometheus-operator resources generated for the components
+type Monitoring struct {
+	// Enabled creates a ServiceMonitor per component and a PrometheusRule, when the
+	// monitoring.coreos.com CRDs are installed
+	Enabled bool `json:"enabled"`
+	// Interval is the scrape interval of the ServiceMonitors, defaults to 30s
+	// +optional
+	Interval string `json:"interval,omitempty"`
+	// DisableRules skips the PrometheusRule
+	// +optional
+	DisableRules bool `json:"disableRules,omitempty"`
+	// Labels are added to the generated resources, to match the selectors of the Prometheus instance
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Dashboards installs the Grafana dashboards of the components, independently of Enabled
+	// +optional
+	Dashboards *GrafanaDashboards `json:"dashboards,omitempty"`
+}
+
+// GrafanaDashboards installs the Grafana dashboards matching the release of each component as
+// ConfigMaps, discovered by the grafana sidecar through their labels
+type GrafanaDashboards struct {
+	Enabled bool `json:"enabled"`
+	// Namespace of the ConfigMaps, defaults to the target namespace
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Labels of the ConfigMaps watched by the grafana sidecar, defaults to grafana_dashboard: "1"
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // TektonConfigStatus defines the observed state of TektonConfig
@@ -139,6 +200,45 @@ type TektonConfigStatus struct {
 	// The current installer set name
 	// +optional
 	TektonInstallerSet map[string]string `json:"tektonInstallerSets,omitempty"`
+
+	// PlatformReport refers to the inventory of the installed components
+	// +optional
+	PlatformReport *PlatformReportStatus `json:"platformReport,omitempty"`
+
+	// Components is the state of the components installed by TektonConfig
+	// +optional
+	Components []ComponentStatus `json:"components,omitempty"`
+}
+
+// ComponentStatus is the state of a component installed by TektonConfig
+type ComponentStatus struct {
+	// Name is the name of the component, the kind of its custom resource
+	Name string `json:"name"`
+	// DependsOn is the list of components which must be ready before
+	// this component is reconciled
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// State is one of Ready, NotReady, WaitingForDependencies or Disabled
+	State string `json:"state"`
+	// Message explains why the component is not ready
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// PlatformReportStatus refers to the ConfigMap holding the CycloneDX bill of
+// materials of the installed components
+type PlatformReportStatus struct {
+	// ConfigMap is the name of the ConfigMap, in the target namespace
+	ConfigMap string `json:"configMap"`
+	// Format is the format of the report
+	Format string `json:"format"`
+	// Hash is the hash of the reported components
+	Hash string `json:"hash,omitempty"`
+	// Components is the number of reported components
+	Components int `json:"components"`
+	// LastUpdateTime is the time the report was last changed
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
 }
 
 func (in *TektonConfigStatus) MarkInstallerSetReady() {
@@ -180,10 +280,108 @@ type Config struct {
 	// PriorityClassName holds the priority class to be set to pod template
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// ImagePullSecrets are added to the pod template
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// PodLabels are added to the pod template, the labels of the manifests are kept
+	// as they can be used by the selectors
+	// +optional
+	PodLabels map[string]string `json:"podLabels,omitempty"`
+	// PodAnnotations are added to the pod template
+	// +optional
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+	// PodSecurityContext is merged into the security context of the pod template
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// ContainerSecurityContext is merged into the security context of every container
+	// +optional
+	ContainerSecurityContext *corev1.SecurityContext `json:"containerSecurityContext,omitempty"`
+	// Affinity replaces the affinity of the pod template
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints replace the topology spread constraints of the pod template
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// DefaultResources are set on the containers which do not define the resource
+	// +optional
+	DefaultResources *corev1.ResourceRequirements `json:"defaultResources,omitempty"`
+	// Observability configures the metrics and the traces
+	// +optional
+	Observability *Observability `json:"observability,omitempty"`
+}
+
+// WithOverride returns the config with the fields set in the override replacing its own
+func (c Config) WithOverride(override *Config) Config {
+	if override == nil {
+		return c
+	}
+	if override.NodeSelector != nil {
+		c.NodeSelector = override.NodeSelector
+	}
+	if override.Tolerations != nil {
+		c.Tolerations = override.Tolerations
+	}
+	if override.PriorityClassName != "" {
+		c.PriorityClassName = override.PriorityClassName
+	}
+	if override.ImagePullSecrets != nil {
+		c.ImagePullSecrets = override.ImagePullSecrets
+	}
+	if override.PodLabels != nil {
+		c.PodLabels = override.PodLabels
+	}
+	if override.PodAnnotations != nil {
+		c.PodAnnotations = override.PodAnnotations
+	}
+	if override.PodSecurityContext != nil {
+		c.PodSecurityContext = override.PodSecurityContext
+	}
+	if override.ContainerSecurityContext != nil {
+		c.ContainerSecurityContext = override.ContainerSecurityContext
+	}
+	if override.Affinity != nil {
+		c.Affinity = override.Affinity
+	}
+	if override.TopologySpreadConstraints != nil {
+		c.TopologySpreadConstraints = override.TopologySpreadConstraints
+	}
+	if override.DefaultResources != nil {
+		c.DefaultResources = override.DefaultResources
+	}
+	if override.Observability != nil {
+		c.Observability = override.Observability
+	}
+	return c
+}
+
+// ComponentConfigs overrides the global config for the resources of a single component
+type ComponentConfigs struct {
+	// +optional
+	Pipeline *Config `json:"pipeline,omitempty"`
+	// +optional
+	Trigger *Config `json:"trigger,omitempty"`
+	// +optional
+	Chain *Config `json:"chain,omitempty"`
+	// +optional
+	Result *Config `json:"result,omitempty"`
+	// +optional
+	TektonPruner *Config `json:"tektonpruner,omitempty"`
+	// Pruner overrides the config of the CronJobs of the job based pruner
+	// +optional
+	Pruner *Config `json:"pruner,omitempty"`
+	// +optional
+	Dashboard *Config `json:"dashboard,omitempty"`
+	// +optional
+	Addon *Config `json:"addon,omitempty"`
+	// +optional
+	PipelinesAsCode *Config `json:"pipelinesAsCode,omitempty"`
 }
 
 type Platforms struct {
 	// OpenShift allows configuring openshift specific components and configurations
 	// +optional
 	OpenShift OpenShift `json:"openshift,omitempty"`
+	// Kubernetes allows configuring kubernetes specific configurations
+	// +optional
+	Kubernetes Kubernetes `json:"kubernetes,omitempty"`
 }
@@ -19,10 +19,12 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/tektoncd/operator/pkg/common"
 	"github.com/tektoncd/operator/pkg/reconciler/openshift"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/logging"
@@ -51,9 +53,7 @@ func (tc *TektonConfig) Validate(ctx context.Context) (errs *apis.FieldError) {
 	errs = errs.Also(tc.Spec.CommonSpec.validate("spec"))
 
 	if tc.Spec.Profile != "" {
-		if isValid := isValueInArray(Profiles, tc.Spec.Profile); !isValid {
-			errs = errs.Also(apis.ErrInvalidValue(tc.Spec.Profile, "spec.profile"))
-		}
+		errs = errs.Also(validateProfile(ctx, tc.Spec.Profile, "spec.profile"))
 	}
 
 	if IsOpenShiftPlatform() && tc.Spec.Platforms.OpenShift.PipelinesAsCode != nil {
@@ -102,6 +102,10 @@ func (tc *TektonConfig) Validate(ctx context.Context) (errs *apis.FieldError) {
 		}
 	}
 
+	if !IsOpenShiftPlatform() {
+		errs = errs.Also(tc.Spec.Platforms.Kubernetes.PodSecurity.validate("spec.platforms.kubernetes.podSecurity"))
+	}
+
 	// validate pruner specifications (legacy job-based pruner)
 	errs = errs.Also(tc.Spec.Pruner.validate())
 
@@ -112,6 +116,7 @@ func (tc *TektonConfig) Validate(ctx context.Context) (errs *apis.FieldError) {
 
 	if !tc.Spec.Addon.IsEmpty() {
 		errs = errs.Also(validateAddonParams(tc.Spec.Addon.Params, "spec.addon.params"))
+		errs = errs.Also(validateAddonCatalogs(tc.Spec.Addon.Catalogs, "spec.addon.catalogs"))
 	}
 
 	if !tc.Spec.Hub.IsEmpty() {
@@ -120,12 +125,41 @@ func (tc *TektonConfig) Validate(ctx context.Context) (errs *apis.FieldError) {
 
 	errs = errs.Also(tc.Spec.Pipeline.PipelineProperties.validate("spec.pipeline"))
 
+	errs = errs.Also(tc.Spec.Pipeline.ResolversConfig.validate("spec.pipeline"))
+
 	errs = errs.Also(tc.Spec.Pipeline.Options.validate("spec.pipeline.options"))
+
+	var baseline *Pipeline
+	if apis.IsInUpdate(ctx) {
+		if existing, ok := apis.GetBaseline(ctx).(*TektonConfig); ok && existing != nil {
+			baseline = &existing.Spec.Pipeline
+		}
+	}
+	errs = errs.Also(tc.Spec.Pipeline.ValidateConfig(ctx, baseline, "spec.pipeline"))
+
 	errs = errs.Also(tc.Spec.Hub.Options.validate("spec.hub.options"))
 	errs = errs.Also(tc.Spec.Dashboard.Options.validate("spec.dashboard.options"))
 	errs = errs.Also(tc.Spec.Chain.Options.validate("spec.chain.options"))
 	errs = errs.Also(tc.Spec.Trigger.Options.validate("spec.trigger.options"))
+	errs = errs.Also(tc.Spec.Trigger.EventListenerTLS.validate("spec.trigger.eventListenerTLS"))
 	errs = errs.Also(tc.Spec.Result.Options.validate("spec.result.options"))
+	errs = errs.Also(tc.Spec.Result.validateDBBackup("spec.result"))
+	errs = errs.Also(tc.Spec.Result.Retention.validate("spec.result.retention"))
+	errs = errs.Also(tc.Spec.Result.validateS3Logs("spec.result"))
+
+	errs = errs.Also(validateResourceSelectors(tc.Spec.UnmanagedResources, "spec.unmanagedResources"))
+
+	errs = errs.Also(tc.Spec.Config.Observability.validate("spec.config.observability"))
+	errs = errs.Also(tc.Spec.Monitoring.validate("spec.monitoring"))
+	errs = errs.Also(tc.Spec.ComponentConfig.validate("spec.componentConfig"))
+
+	if tc.Spec.DriftPolicy != "" && !isValueInArray(DriftPolicies, tc.Spec.DriftPolicy) {
+		errs = errs.Also(apis.ErrInvalidValue(tc.Spec.DriftPolicy, "spec.driftPolicy"))
+	}
+
+	if ssa := tc.Spec.ServerSideApply; ssa != nil && ssa.ConflictPolicy != "" && !isValueInArray(ConflictPolicies, ssa.ConflictPolicy) {
+		errs = errs.Also(apis.ErrInvalidValue(ssa.ConflictPolicy, "spec.serverSideApply.conflictPolicy"))
+	}
 
 	return errs.Also(tc.Spec.Trigger.TriggersProperties.validate("spec.trigger"))
 }
@@ -169,6 +203,73 @@ func (p Prune) validate() *apis.FieldError {
 	return errs
 }
 
+// prometheusDuration is the pattern of the durations of the prometheus-operator resources
+var prometheusDuration = regexp.MustCompile(`^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$`)
+
+func (m *Monitoring) validate(path string) *apis.FieldError {
+	if m == nil {
+		return nil
+	}
+	var errs *apis.FieldError
+	if m.Interval != "" && !prometheusDuration.MatchString(m.Interval) {
+		errs = errs.Also(apis.ErrInvalidValue(m.Interval, path+".interval", "expected a prometheus duration, eg. 30s"))
+	}
+	if d := m.Dashboards; d != nil && d.Namespace != "" {
+		if msgs := validation.IsDNS1123Label(d.Namespace); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(d.Namespace, path+".dashboards.namespace", msgs...))
+		}
+	}
+	return errs
+}
+
+func (cc ComponentConfigs) validate(path string) (errs *apis.FieldError) {
+	for component, config := range map[string]*Config{
+		"pipeline":        cc.Pipeline,
+		"trigger":         cc.Trigger,
+		"chain":           cc.Chain,
+		"result":          cc.Result,
+		"tektonpruner":    cc.TektonPruner,
+		"pruner":          cc.Pruner,
+		"dashboard":       cc.Dashboard,
+		"addon":           cc.Addon,
+		"pipelinesAsCode": cc.PipelinesAsCode,
+	} {
+		if config != nil {
+			errs = errs.Also(config.Observability.validate(fmt.Sprintf("%s.%s.observability", path, component)))
+		}
+	}
+	return errs
+}
+
+func validateResourceSelectors(selectors []ResourceSelector, path string) *apis.FieldError {
+	var errs *apis.FieldError
+	for i, selector := range selectors {
+		if selector.Kind == "" {
+			errs = errs.Also(apis.ErrMissingField(fmt.Sprintf("%s[%d].kind", path, i)))
+		}
+		if selector.Name == "" {
+			errs = errs.Also(apis.ErrMissingField(fmt.Sprintf("%s[%d].name", path, i)))
+		}
+	}
+	return errs
+}
+
+// validateProfile accepts the builtin profiles, and the TektonProfiles when the
+// webhook can resolve them
+func validateProfile(ctx context.Context, profile, path string) *apis.FieldError {
+	if isValueInArray(Profiles, profile) {
+		return nil
+	}
+	getter := profileGetterFrom(ctx)
+	if getter == nil {
+		return apis.ErrInvalidValue(profile, path)
+	}
+	if _, err := getter(ctx, profile); err != nil {
+		return apis.ErrInvalidValue(profile, path).Also(apis.ErrGeneric(fmt.Sprintf("failed to get the TektonProfile: %v", err), path))
+	}
+	return nil
+}
+
 func isValueInArray(arr []string, key string) bool {
 	for _, p := range arr {
 		if p == key {
@@ -219,3 +320,28 @@ func compareSCCsWithAllNamespaces(ctx context.Context, maxAllowedSCC string) (*a
 	}
 	return sccErrors, nil
 }
+
+func (ps *PodSecurity) validate(path string) (errs *apis.FieldError) {
+	if ps == nil {
+		return nil
+	}
+	if ps.Default != "" && !isValueInArray(PodSecurityLevels, ps.Default) {
+		errs = errs.Also(apis.ErrInvalidValue(ps.Default, path+".default", fmt.Sprintf("expected one of %v", PodSecurityLevels)))
+	}
+	if ps.MaxAllowed != "" {
+		if !isValueInArray(PodSecurityLevels, ps.MaxAllowed) {
+			errs = errs.Also(apis.ErrInvalidValue(ps.MaxAllowed, path+".maxAllowed", fmt.Sprintf("expected one of %v", PodSecurityLevels)))
+		} else if !PodSecurityAllowed(ps.Default, ps.MaxAllowed) {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("maxAllowed level (%s) must be less restrictive than the default level (%s)", ps.MaxAllowed, ps.Default), path+".maxAllowed"))
+		}
+	}
+	if ps.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(ps.NamespaceSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), path+".namespaceSelector"))
+		}
+	} else if ps.Default != "" {
+		// the default level is set on the selected namespaces only
+		errs = errs.Also(apis.ErrMissingField(path + ".namespaceSelector"))
+	}
+	return errs
+}
@@ -367,3 +367,129 @@ func Test_ValidateTektonConfig_PrunerConfig_Invalid(t *testing.T) {
 	err := tc.Validate(context.TODO())
 	assert.ErrorContains(t, err, "pruner config validation failed")
 }
+
+func Test_ValidateTektonConfig_InvalidUnmanagedResources(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "config",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "namespace",
+			},
+			Profile: "all",
+			Pruner:  Prune{Disabled: true},
+			UnmanagedResources: []ResourceSelector{
+				{Kind: "Deployment", Name: "tekton-pipelines-webhook"},
+				{Kind: "ConfigMap"},
+			},
+		},
+	}
+
+	err := tc.Validate(context.TODO())
+	assert.Equal(t, "missing field(s): spec.unmanagedResources[1].name", err.Error())
+}
+
+func Test_ValidateTektonConfig_InvalidDriftPolicy(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "config",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "namespace",
+			},
+			Profile:     "all",
+			Pruner:      Prune{Disabled: true},
+			DriftPolicy: "Ignore",
+		},
+	}
+
+	err := tc.Validate(context.TODO())
+	assert.Equal(t, "invalid value: Ignore: spec.driftPolicy", err.Error())
+}
+
+func Test_ValidateTektonConfig_InvalidConflictPolicy(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "config",
+		},
+		Spec: TektonConfigSpec{
+			CommonSpec: CommonSpec{
+				TargetNamespace: "namespace",
+			},
+			Profile:         "all",
+			Pruner:          Prune{Disabled: true},
+			ServerSideApply: &ServerSideApply{ConflictPolicy: "Ignore"},
+		},
+	}
+
+	err := tc.Validate(context.TODO())
+	assert.Equal(t, "invalid value: Ignore: spec.serverSideApply.conflictPolicy", err.Error())
+
+	tc.Spec.ServerSideApply.ConflictPolicy = ConflictPolicyReport
+	assert.Assert(t, tc.Validate(context.TODO()) == nil)
+}
+
+func TestMonitoringValidate(t *testing.T) {
+	for interval, err := range map[string]string{
+		"":           "",
+		"30s":        "",
+		"1m30s":      "",
+		"30 seconds": "invalid value: 30 seconds: spec.monitoring.interval\nexpected a prometheus duration, eg. 30s",
+		"1.5m":       "invalid value: 1.5m: spec.monitoring.interval\nexpected a prometheus duration, eg. 30s",
+	} {
+		errs := (&Monitoring{Enabled: true, Interval: interval}).validate("spec.monitoring")
+		assert.Equal(t, err, errs.Error(), interval)
+	}
+
+	errs := (&Monitoring{Dashboards: &GrafanaDashboards{Enabled: true, Namespace: "Grafana"}}).validate("spec.monitoring")
+	assert.ErrorContains(t, errs, "invalid value: Grafana: spec.monitoring.dashboards.namespace")
+}
+
+func TestPodSecurityValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		podSecurity *PodSecurity
+		err         string
+	}{
+		{name: "unset"},
+		{name: "default", podSecurity: &PodSecurity{Default: PodSecurityRestricted, NamespaceSelector: &metav1.LabelSelector{}}},
+		{name: "maxAllowed", podSecurity: &PodSecurity{MaxAllowed: PodSecurityBaseline}},
+		{
+			name:        "default without namespaceSelector",
+			podSecurity: &PodSecurity{Default: PodSecurityRestricted, MaxAllowed: PodSecurityBaseline},
+			err:         "missing field(s): spec.platforms.kubernetes.podSecurity.namespaceSelector",
+		},
+		{
+			name:        "invalid default",
+			podSecurity: &PodSecurity{Default: "strict", NamespaceSelector: &metav1.LabelSelector{}},
+			err:         "invalid value: strict: spec.platforms.kubernetes.podSecurity.default\nexpected one of [restricted baseline privileged]",
+		},
+		{
+			name:        "maxAllowed more restrictive than the default",
+			podSecurity: &PodSecurity{Default: PodSecurityBaseline, MaxAllowed: PodSecurityRestricted, NamespaceSelector: &metav1.LabelSelector{}},
+			err:         "maxAllowed level (restricted) must be less restrictive than the default level (baseline): spec.platforms.kubernetes.podSecurity.maxAllowed",
+		},
+		{
+			name: "invalid namespaceSelector",
+			podSecurity: &PodSecurity{Default: PodSecurityRestricted, NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Is"}},
+			}},
+			err: `invalid value: "Is" is not a valid label selector operator: spec.platforms.kubernetes.podSecurity.namespaceSelector`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := test.podSecurity.validate("spec.platforms.kubernetes.podSecurity")
+			assert.Equal(t, test.err, errs.Error())
+		})
+	}
+}
+
+func TestPodSecurityAllowed(t *testing.T) {
+	assert.Equal(t, PodSecurityAllowed(PodSecurityRestricted, PodSecurityBaseline), true)
+	assert.Equal(t, PodSecurityAllowed(PodSecurityBaseline, PodSecurityBaseline), true)
+	assert.Equal(t, PodSecurityAllowed(PodSecurityPrivileged, PodSecurityBaseline), false)
+	assert.Equal(t, PodSecurityAllowed("unknown", PodSecurityRestricted), true)
+}
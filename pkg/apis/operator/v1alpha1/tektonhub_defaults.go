@@ -34,4 +34,6 @@ func (th *TektonHub) SetDefaults(ctx context.Context) {
 	if th.Spec.Api.CatalogRefreshInterval == "" {
 		th.Spec.Api.CatalogRefreshInterval = "30m"
 	}
+
+	th.Spec.Db.Backup.setDefaults()
 }
@@ -54,6 +54,10 @@ type TektonHubSpec struct {
 	Db         DbSpec         `json:"db,omitempty"`
 	Api        ApiSpec        `json:"api,omitempty"`
 	CustomLogo CustomLogoSpec `json:"customLogo,omitempty"`
+	// Ingress exposes the api, auth and ui of the Hub on Kubernetes,
+	// on OpenShift they are exposed with Routes
+	// +optional
+	Ingress *HubIngressSpec `json:"ingress,omitempty"`
 }
 
 // Hub defines the field to customize Hub component
@@ -67,6 +71,9 @@ type Hub struct {
 
 type DbSpec struct {
 	DbSecretName string `json:"secret,omitempty"`
+	// Backup schedules backups of the operator managed database
+	// +optional
+	Backup *DBBackup `json:"backup,omitempty"`
 }
 
 type ApiSpec struct {
@@ -77,6 +84,38 @@ type ApiSpec struct {
 	CatalogRefreshInterval string `json:"catalogRefreshInterval,omitempty"`
 }
 
+// HubIngressSpec configures the Ingresses created for the Hub
+type HubIngressSpec struct {
+	// IngressClassName of the Ingresses, the default class of the cluster is used when empty
+	// +optional
+	IngressClassName string `json:"ingressClassName,omitempty"`
+	// Annotations added to the Ingresses, e.g. to request certificates from cert-manager
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Api         HubIngressHost    `json:"api"`
+	// Auth exposes the auth server, required to login with a git provider
+	// +optional
+	Auth *HubIngressHost `json:"auth,omitempty"`
+	// +optional
+	Ui *HubIngressHost `json:"ui,omitempty"`
+}
+
+// HubIngressHost is the host of an Ingress, served with TLS when TLSSecretName is set
+type HubIngressHost struct {
+	Host string `json:"host"`
+	// TLSSecretName is the name of a secret of the target namespace holding the certificate of the host
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+// URL returns the URL of the host
+func (h HubIngressHost) URL() string {
+	if h.TLSSecretName != "" {
+		return "https://" + h.Host
+	}
+	return "http://" + h.Host
+}
+
 type Category struct {
 	Name string `json:"name,omitempty"`
 }
@@ -134,6 +173,10 @@ type TektonHubStatus struct {
 	// The current installer set name
 	// +optional
 	HubInstallerSet map[string]string `json:"hubInstallerSets,omitempty"`
+
+	// The last backup and restore of the operator managed database
+	// +optional
+	DBBackup *DBBackupStatus `json:"dbBackup,omitempty"`
 }
 
 func (in *TektonHubStatus) MarkInstallerSetReady() {
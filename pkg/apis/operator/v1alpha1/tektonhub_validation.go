@@ -18,7 +18,10 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 )
 
@@ -40,6 +43,39 @@ func validateHubParams(params []Param, pathToParams string) *apis.FieldError {
 	return errs
 }
 
+func (i *HubIngressSpec) validate(path string) (errs *apis.FieldError) {
+	if i == nil {
+		return nil
+	}
+	if IsOpenShiftPlatform() {
+		return apis.ErrGeneric("ingress is not supported on OpenShift, the Hub is exposed with Routes", path)
+	}
+
+	hosts := map[string]string{}
+	validateHost := func(h *HubIngressHost, hostPath string) {
+		if h == nil {
+			return
+		}
+		if h.Host == "" {
+			errs = errs.Also(apis.ErrMissingField(hostPath + ".host"))
+			return
+		}
+		if msgs := validation.IsDNS1123Subdomain(h.Host); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s: %s", h.Host, strings.Join(msgs, ", ")), hostPath+".host"))
+			return
+		}
+		if other, found := hosts[h.Host]; found {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("host %s is already used by %s", h.Host, other), hostPath+".host"))
+			return
+		}
+		hosts[h.Host] = hostPath
+	}
+	validateHost(&i.Api, path+".api")
+	validateHost(i.Auth, path+".auth")
+	validateHost(i.Ui, path+".ui")
+	return errs
+}
+
 func (th *TektonHub) Validate(ctx context.Context) (errs *apis.FieldError) {
 	if apis.IsInDelete(ctx) {
 		return nil
@@ -61,10 +97,14 @@ func (th *TektonHub) Validate(ctx context.Context) (errs *apis.FieldError) {
 		errs = errs.Also(apis.ErrInvalidValue(th.Spec.Db.DbSecretName, "spec.db.secret"))
 	}
 
+	errs = errs.Also(th.Spec.Db.Backup.validate("spec.db.backup"))
+
 	// validate api secret name
 	if th.Spec.Api.ApiSecretName != "" && th.Spec.Api.ApiSecretName != HubApiSecretName {
 		errs = errs.Also(apis.ErrInvalidValue(th.Spec.Api.ApiSecretName, "spec.api.secret"))
 	}
 
+	errs = errs.Also(th.Spec.Ingress.validate("spec.ingress"))
+
 	return errs
 }
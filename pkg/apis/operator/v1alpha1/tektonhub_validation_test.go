@@ -171,3 +171,71 @@ func Test_ValidateTektonHub_UpdateTargetNamespace(t *testing.T) {
 	err := updatedTH.Validate(ctx)
 	assert.Equal(t, `doesn't allow to update targetNamespace, delete existing TektonHub object and create the updated TektonHub object: spec.targetNamespace`, err.Error())
 }
+
+func Test_ValidateTektonHub_Ingress(t *testing.T) {
+	tests := []struct {
+		name      string
+		ingress   *HubIngressSpec
+		openshift bool
+		err       string
+	}{
+		{
+			name: "valid ingress",
+			ingress: &HubIngressSpec{
+				Api:  HubIngressHost{Host: "api.hub.example.com", TLSSecretName: "hub-tls"},
+				Auth: &HubIngressHost{Host: "auth.hub.example.com"},
+				Ui:   &HubIngressHost{Host: "hub.example.com"},
+			},
+		},
+		{
+			name:    "missing api host",
+			ingress: &HubIngressSpec{Ui: &HubIngressHost{Host: "hub.example.com"}},
+			err:     "missing field(s): spec.ingress.api.host",
+		},
+		{
+			name:    "invalid host",
+			ingress: &HubIngressSpec{Api: HubIngressHost{Host: "https://api.hub.example.com"}},
+			err:     "invalid value: https://api.hub.example.com: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*'): spec.ingress.api.host",
+		},
+		{
+			name: "host used twice",
+			ingress: &HubIngressSpec{
+				Api: HubIngressHost{Host: "hub.example.com"},
+				Ui:  &HubIngressHost{Host: "hub.example.com"},
+			},
+			err: "host hub.example.com is already used by spec.ingress.api: spec.ingress.ui.host",
+		},
+		{
+			name:      "ingress on openshift",
+			ingress:   &HubIngressSpec{Api: HubIngressHost{Host: "api.hub.example.com"}},
+			openshift: true,
+			err:       "ingress is not supported on OpenShift, the Hub is exposed with Routes: spec.ingress",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.openshift {
+				t.Setenv("PLATFORM", "openshift")
+			}
+			th := &TektonHub{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "hub",
+				},
+				Spec: TektonHubSpec{
+					CommonSpec: CommonSpec{
+						TargetNamespace: "tekton-pipelines",
+					},
+					Ingress: test.ingress,
+				},
+			}
+
+			err := th.Validate(context.TODO())
+			if test.err == "" {
+				assert.Assert(t, err == nil)
+			} else {
+				assert.Equal(t, test.err, err.Error())
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/apis"
 )
@@ -31,6 +34,17 @@ const (
 	ControllerReady      apis.ConditionType = "ControllersReady"
 	AllDeploymentsReady  apis.ConditionType = "AllDeploymentsReady"
 	JobsInstalled        apis.ConditionType = "JobsInstalled"
+	// ManifestSize is a warning condition, not part of the Ready condition, it is
+	// False when the stored spec is close to the object size limit of etcd
+	ManifestSize apis.ConditionType = "ManifestSizeWithinLimit"
+)
+
+const (
+	// InstallerSetCompressThreshold is the size of the JSON of the manifests above which they are stored compressed
+	InstallerSetCompressThreshold = 256 * 1024
+	// InstallerSetSizeWarningThreshold is the size of the stored spec above which the ManifestSize
+	// condition warns, the default object size limit of etcd is 1.5MiB
+	InstallerSetSizeWarningThreshold = 1024 * 1024
 )
 
 var (
@@ -181,3 +195,23 @@ func (tis *TektonInstallerSetStatus) MarkJobsInstallationFailed(msg string) {
 		"Error",
 		"Install failed with message: %s", msg)
 }
+
+// MarkManifestSize sets the ManifestSize warning condition from the size of the stored spec,
+// it does not change the Ready condition
+func (tis *TektonInstallerSetStatus) MarkManifestSize(size int) {
+	if size < InstallerSetSizeWarningThreshold {
+		installerSetCondSet.Manage(tis).SetCondition(apis.Condition{
+			Type:   ManifestSize,
+			Status: corev1.ConditionTrue,
+		})
+		return
+	}
+	installerSetCondSet.Manage(tis).SetCondition(apis.Condition{
+		Type:     ManifestSize,
+		Status:   corev1.ConditionFalse,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "ManifestSizeNearLimit",
+		Message: fmt.Sprintf("the spec is %d bytes, close to the object size limit of etcd, split the resources in more installer sets",
+			size),
+	})
+}
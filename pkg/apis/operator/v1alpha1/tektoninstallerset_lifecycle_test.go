@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
 	apistest "knative.dev/pkg/apis/testing"
 )
 
@@ -150,3 +151,28 @@ func TestTektonInstallerSetErrorPath(t *testing.T) {
 		t.Errorf("tt.IsReady() = %v, want false", ready)
 	}
 }
+
+func TestTektonInstallerSetManifestSize(t *testing.T) {
+	tis := &TektonInstallerSetStatus{}
+	tis.InitializeConditions()
+	tis.MarkCRDsInstalled()
+	tis.MarkClustersScopedResourcesInstalled()
+	tis.MarkNamespaceScopedResourcesInstalled()
+	tis.MarkDeploymentsAvailable()
+	tis.MarkStatefulSetReady()
+	tis.MarkWebhookReady()
+	tis.MarkControllerReady()
+	tis.MarkAllDeploymentsReady()
+	tis.MarkJobsInstalled()
+
+	tis.MarkManifestSize(1024)
+	apistest.CheckConditionSucceeded(tis, ManifestSize, t)
+
+	// the warning does not change the Ready condition
+	tis.MarkManifestSize(InstallerSetSizeWarningThreshold)
+	apistest.CheckConditionFailed(tis, ManifestSize, t)
+	if c := tis.GetCondition(ManifestSize); c.Severity != apis.ConditionSeverityWarning {
+		t.Errorf("expected a warning severity, got %q", c.Severity)
+	}
+	apistest.CheckConditionSucceeded(tis, apis.ConditionReady, t)
+}
@@ -17,8 +17,15 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+
 	mf "github.com/manifestival/manifestival"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
@@ -38,11 +45,139 @@ type TektonInstallerSet struct {
 // TektonInstallerSetSpec defines the desired state of TektonInstallerSet
 type TektonInstallerSetSpec struct {
 	Manifests mf.Slice `json:"manifests,omitempty"`
+	// CompressedManifests holds the gzip compressed JSON of the manifests,
+	// in place of Manifests, when the manifests are larger than InstallerSetCompressThreshold
+	// +optional
+	CompressedManifests []byte `json:"compressedManifests,omitempty"`
+}
+
+// SetManifests stores the resources in the spec, compressed when their JSON
+// is larger than InstallerSetCompressThreshold
+func (s *TektonInstallerSetSpec) SetManifests(resources []unstructured.Unstructured) error {
+	s.Manifests, s.CompressedManifests = resources, nil
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return err
+	}
+	if len(data) <= InstallerSetCompressThreshold {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(data); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	s.Manifests, s.CompressedManifests = nil, buf.Bytes()
+	return nil
+}
+
+// GetManifests returns the resources of the spec, decompressed if they are stored compressed
+func (s *TektonInstallerSetSpec) GetManifests() (mf.Slice, error) {
+	if len(s.CompressedManifests) == 0 {
+		return s.Manifests, nil
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(s.CompressedManifests))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the manifests: %w", err)
+	}
+	defer gz.Close()
+	data, err := io.ReadAll(gz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the manifests: %w", err)
+	}
+	resources := mf.Slice{}
+	if err := json.Unmarshal(data, &resources); err != nil {
+		return nil, fmt.Errorf("failed to decode the manifests: %w", err)
+	}
+	return resources, nil
 }
 
 // TektonInstallerSetStatus defines the observed state of TektonInstallerSet
 type TektonInstallerSetStatus struct {
 	duckv1.Status `json:",inline"`
+
+	// UnmanagedResources lists the resources skipped by the installer
+	// as they are opted out of reconciliation
+	// +optional
+	UnmanagedResources []UnmanagedResource `json:"unmanagedResources,omitempty"`
+
+	// DriftedResources lists the resources which were changed on the cluster
+	// after the installer applied them
+	// +optional
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// AppliedSpecHash is the hash of the spec the installer applied successfully,
+	// any change on the resources is a drift as long as the spec is not changed
+	// +optional
+	AppliedSpecHash string `json:"appliedSpecHash,omitempty"`
+
+	// ConflictedResources lists the resources left unchanged as fields applied with
+	// server-side apply are owned by other field managers
+	// +optional
+	ConflictedResources []ConflictedResource `json:"conflictedResources,omitempty"`
+}
+
+// ResourceSelector selects a resource by its apiVersion, kind, namespace and name
+type ResourceSelector struct {
+	// APIVersion of the resource, matches any apiVersion if empty
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	// Namespace of the resource, matches any namespace if empty
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// Matches returns true if the given resource is selected by the selector
+func (rs ResourceSelector) Matches(apiVersion, kind, namespace, name string) bool {
+	if rs.APIVersion != "" && rs.APIVersion != apiVersion {
+		return false
+	}
+	if rs.Namespace != "" && rs.Namespace != namespace {
+		return false
+	}
+	return rs.Kind == kind && rs.Name == name
+}
+
+// UnmanagedResource is a resource which the installer did not update
+type UnmanagedResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// Reason why the resource is not managed
+	Reason string `json:"reason,omitempty"`
+}
+
+// DriftedResource is a resource which differs from the manifest applied by the installer
+type DriftedResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// Fields which differ from the manifest
+	Fields []string `json:"fields,omitempty"`
+	// LastChangedBy is the field manager who last changed the resource, if known
+	// +optional
+	LastChangedBy string `json:"lastChangedBy,omitempty"`
+}
+
+// ConflictedResource is a resource which could not be applied with server-side apply
+// as some of its fields are owned by other field managers
+type ConflictedResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// Fields in conflict
+	Fields []string `json:"fields,omitempty"`
+	// Managers owning the fields in conflict
+	Managers []string `json:"managers,omitempty"`
 }
 
 // TektonInstallerSetList contains a list of TektonInstallerSet
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func testConfigMap(name, data string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "tekton-pipelines"},
+		"data":       map[string]interface{}{"data": data},
+	}}
+}
+
+func TestTektonInstallerSetSpecManifests(t *testing.T) {
+	small := []unstructured.Unstructured{testConfigMap("small", "value")}
+	spec := TektonInstallerSetSpec{}
+	assert.NilError(t, spec.SetManifests(small))
+	assert.Equal(t, len(spec.CompressedManifests), 0)
+	assert.DeepEqual(t, []unstructured.Unstructured(spec.Manifests), small)
+
+	// the manifests above the threshold are stored compressed
+	large := []unstructured.Unstructured{
+		testConfigMap("first", strings.Repeat("a", InstallerSetCompressThreshold)),
+		testConfigMap("second", "value"),
+	}
+	assert.NilError(t, spec.SetManifests(large))
+	assert.Assert(t, spec.Manifests == nil)
+	assert.Assert(t, len(spec.CompressedManifests) > 0)
+	assert.Assert(t, len(spec.CompressedManifests) < InstallerSetCompressThreshold/10)
+
+	manifests, err := spec.GetManifests()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []unstructured.Unstructured(manifests), large)
+
+	// the manifests stored uncompressed replace the compressed ones
+	assert.NilError(t, spec.SetManifests(small))
+	assert.Assert(t, spec.CompressedManifests == nil)
+	manifests, err = spec.GetManifests()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []unstructured.Unstructured(manifests), small)
+
+	spec = TektonInstallerSetSpec{CompressedManifests: []byte("not gzip")}
+	_, err = spec.GetManifests()
+	assert.ErrorContains(t, err, "failed to decompress the manifests")
+}
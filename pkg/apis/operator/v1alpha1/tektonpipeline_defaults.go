@@ -152,6 +152,9 @@ func (p *Pipeline) setDefaults() {
 	if p.EnableGitResolver == nil {
 		p.EnableGitResolver = ptr.Bool(true)
 	}
+	if p.EnableHttpResolver == nil {
+		p.EnableHttpResolver = ptr.Bool(true)
+	}
 
 	// Statefulset Ordinals
 	// if StatefulSet Ordinals mode, buckets should be equal to replicas
@@ -74,6 +74,7 @@ func Test_SetDefaults_PipelineProperties(t *testing.T) {
 			EnableBundlesResolver: ptr.Bool(true),
 			EnableHubResolver:     ptr.Bool(true),
 			EnableGitResolver:     ptr.Bool(true),
+			EnableHttpResolver:    ptr.Bool(true),
 			EnableClusterResolver: ptr.Bool(true),
 		},
 	}
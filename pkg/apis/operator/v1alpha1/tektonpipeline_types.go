@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -192,6 +194,7 @@ type Resolvers struct {
 	EnableHubResolver     *bool `json:"enable-hub-resolver,omitempty"`
 	EnableGitResolver     *bool `json:"enable-git-resolver,omitempty"`
 	EnableClusterResolver *bool `json:"enable-cluster-resolver,omitempty"`
+	EnableHttpResolver    *bool `json:"enable-http-resolver,omitempty"`
 	ResolversConfig       `json:",inline"`
 }
 
@@ -201,4 +204,27 @@ type ResolversConfig struct {
 	HubResolverConfig     map[string]string `json:"hub-resolver-config,omitempty"`
 	GitResolverConfig     map[string]string `json:"git-resolver-config,omitempty"`
 	ClusterResolverConfig map[string]string `json:"cluster-resolver-config,omitempty"`
+	HttpResolverConfig    map[string]string `json:"http-resolver-config,omitempty"`
+	// CustomResolvers are remote resolvers deployed along the resolvers of Pipelines
+	// +optional
+	CustomResolvers []CustomResolver `json:"customResolvers,omitempty"`
+}
+
+// CustomResolver is a remote resolver deployed by the operator in the target namespace
+type CustomResolver struct {
+	// Name of the Deployment, the ServiceAccount and the RBAC of the resolver
+	Name string `json:"name"`
+	// Image of the resolver, the registry is overridden as for the images of the components
+	Image string `json:"image"`
+	// ResolverType is the name resolving the remote references, the `resolver` field of a taskRef
+	ResolverType string `json:"resolverType"`
+	// Config is the data of the ConfigMap of the resolver, named <name>-config
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+	// Rules are added to the ClusterRole of the resolver, to access the resources it resolves
+	// +optional
+	Rules []rbacv1.PolicyRule `json:"rules,omitempty"`
+	// Env are added to the container of the resolver
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 )
 
@@ -33,6 +34,8 @@ var (
 	validatePipelineEnforceNonFalsifiability  = sets.NewString("", config.EnforceNonfalsifiabilityNone, config.EnforceNonfalsifiabilityWithSpire)
 	validatePipelineCoschedule                = sets.NewString("", config.CoscheduleDisabled, config.CoscheduleWorkspaces, config.CoschedulePipelineRuns, config.CoscheduleIsolatePipelineRun)
 	validatePipelineInlineSpecDisable         = sets.NewString("", "pipeline", "pipelinerun", "taskrun")
+	// the resolver types of the resolvers of Pipelines
+	builtinResolverTypes = sets.NewString("bundles", "cluster", "git", "hub", "http")
 )
 
 func (tp *TektonPipeline) Validate(ctx context.Context) (errs *apis.FieldError) {
@@ -51,8 +54,18 @@ func (tp *TektonPipeline) Validate(ctx context.Context) (errs *apis.FieldError)
 
 	errs = errs.Also(tp.Spec.PipelineProperties.validate("spec"))
 
+	errs = errs.Also(tp.Spec.ResolversConfig.validate("spec"))
+
 	errs = errs.Also(tp.Spec.Options.validate("spec"))
 
+	var baseline *Pipeline
+	if apis.IsInUpdate(ctx) {
+		if existing, ok := apis.GetBaseline(ctx).(*TektonPipeline); ok && existing != nil {
+			baseline = &existing.Spec.Pipeline
+		}
+	}
+	errs = errs.Also(tp.Spec.Pipeline.ValidateConfig(ctx, baseline, "spec"))
+
 	return errs
 }
 
@@ -104,3 +117,32 @@ func (p *PipelineProperties) validate(path string) (errs *apis.FieldError) {
 
 	return errs
 }
+
+func (r *ResolversConfig) validate(path string) (errs *apis.FieldError) {
+	names := sets.NewString()
+	types := sets.NewString()
+	for i, resolver := range r.CustomResolvers {
+		resolverPath := fmt.Sprintf("%s.customResolvers[%d]", path, i)
+		if msgs := validation.IsDNS1123Label(resolver.Name); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(resolver.Name, resolverPath+".name", msgs...))
+		} else if names.Has(resolver.Name) {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("duplicate resolver name %q", resolver.Name), resolverPath+".name"))
+		}
+		names.Insert(resolver.Name)
+
+		if resolver.Image == "" {
+			errs = errs.Also(apis.ErrMissingField(resolverPath + ".image"))
+		}
+
+		switch {
+		case resolver.ResolverType == "":
+			errs = errs.Also(apis.ErrMissingField(resolverPath + ".resolverType"))
+		case builtinResolverTypes.Has(resolver.ResolverType):
+			errs = errs.Also(apis.ErrInvalidValue(resolver.ResolverType, resolverPath+".resolverType", "the type of a resolver of Pipelines"))
+		case types.Has(resolver.ResolverType):
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("duplicate resolver type %q", resolver.ResolverType), resolverPath+".resolverType"))
+		}
+		types.Insert(resolver.ResolverType)
+	}
+	return errs
+}
@@ -264,3 +264,50 @@ func Test_ValidateTektonPipeline_OnDelete(t *testing.T) {
 		t.Errorf("ValidateTektonPipeline.Validate() on Delete expected no error, but got one, ValidateTektonPipeline: %v", err)
 	}
 }
+
+func TestValidateTektonPipelineCustomResolvers(t *testing.T) {
+	resolver := func(name, resolverType string) CustomResolver {
+		return CustomResolver{Name: name, Image: "quay.io/example/resolver:v1", ResolverType: resolverType}
+	}
+	tests := []struct {
+		name      string
+		resolvers []CustomResolver
+		err       string
+	}{
+		{
+			name:      "valid",
+			resolvers: []CustomResolver{resolver("artifacts", "artifacts"), resolver("oci", "oci")},
+		},
+		{
+			name:      "invalid name",
+			resolvers: []CustomResolver{resolver("Artifacts", "artifacts")},
+			err:       "invalid value: Artifacts: spec.customResolvers[0].name",
+		},
+		{
+			name:      "missing image and type",
+			resolvers: []CustomResolver{{Name: "artifacts"}},
+			err:       "missing field(s): spec.customResolvers[0].image, spec.customResolvers[0].resolverType",
+		},
+		{
+			name:      "type of a resolver of pipelines",
+			resolvers: []CustomResolver{resolver("my-git", "git")},
+			err:       "invalid value: git: spec.customResolvers[0].resolverType\nthe type of a resolver of Pipelines",
+		},
+		{
+			name:      "duplicates",
+			resolvers: []CustomResolver{resolver("artifacts", "artifacts"), resolver("artifacts", "artifacts")},
+			err:       "duplicate resolver name \"artifacts\": spec.customResolvers[1].name\nduplicate resolver type \"artifacts\": spec.customResolvers[1].resolverType",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &ResolversConfig{CustomResolvers: test.resolvers}
+			errs := r.validate("spec")
+			if test.err == "" {
+				assert.Equal(t, "", errs.Error())
+				return
+			}
+			assert.ErrorContains(t, errs, test.err)
+		})
+	}
+}
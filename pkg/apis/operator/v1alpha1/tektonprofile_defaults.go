@@ -0,0 +1,324 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"knative.dev/pkg/apis"
+)
+
+// ProfileGetter returns the TektonProfile with the given name
+type ProfileGetter func(ctx context.Context, name string) (*TektonProfile, error)
+
+type profileGetterKey struct{}
+
+// WithProfileGetter makes the TektonConfig defaulting and validation resolve the
+// TektonProfile referenced by spec.profile with the getter
+func WithProfileGetter(ctx context.Context, getter ProfileGetter) context.Context {
+	return context.WithValue(ctx, profileGetterKey{}, getter)
+}
+
+func profileGetterFrom(ctx context.Context) ProfileGetter {
+	getter, _ := ctx.Value(profileGetterKey{}).(ProfileGetter)
+	return getter
+}
+
+type requestObjectKey struct{}
+
+// WithRequestObject passes the raw object of the admission request to the TektonConfig
+// defaulting, a field present in the request wins over the profile defaults whatever its value
+func WithRequestObject(ctx context.Context, raw []byte) context.Context {
+	return context.WithValue(ctx, requestObjectKey{}, raw)
+}
+
+func requestObjectFrom(ctx context.Context) []byte {
+	raw, _ := ctx.Value(requestObjectKey{}).([]byte)
+	return raw
+}
+
+func (tp *TektonProfile) SetDefaults(ctx context.Context) {}
+
+// defaultsKey returns the defaults of the profile in a canonical form, empty without defaults
+func (ps TektonProfileSpec) defaultsKey() (string, error) {
+	defaults, err := ps.defaults()
+	if err != nil || len(defaults) == 0 {
+		return "", err
+	}
+	raw, err := json.Marshal(defaults)
+	return string(raw), err
+}
+
+func (ps TektonProfileSpec) defaults() (map[string]interface{}, error) {
+	defaults := map[string]interface{}{}
+	if ps.Defaults == nil || len(ps.Defaults.Raw) == 0 {
+		return defaults, nil
+	}
+	if err := json.Unmarshal(ps.Defaults.Raw, &defaults); err != nil {
+		return nil, fmt.Errorf("failed to parse the profile defaults: %w", err)
+	}
+	return defaults, nil
+}
+
+// HasProfileDefaults returns true when the defaults of the profile are the ones last merged
+// in the TektonConfig
+func (tc *TektonConfig) HasProfileDefaults(profile TektonProfileSpec) bool {
+	key, err := profile.defaultsKey()
+	if err != nil {
+		// invalid defaults are never merged
+		return true
+	}
+	return tc.GetAnnotations()[ProfileDefaultsKey] == key
+}
+
+// mergeProfileDefaults merges the defaults of the profile under the explicit spec. The
+// fields taken from the previous defaults of the profile are recorded in an annotation, they
+// follow the profile as long as the TektonConfig keeps their value
+func (tc *TektonConfig) mergeProfileDefaults(ctx context.Context, profile TektonProfileSpec) error {
+	defaults, err := profile.defaults()
+	if err != nil {
+		return err
+	}
+
+	// on update, the fields previously taken from the profile are read from the stored object
+	annotations := tc.GetAnnotations()
+	if old, ok := apis.GetBaseline(ctx).(*TektonConfig); ok {
+		annotations = old.GetAnnotations()
+	}
+	previous, err := annotationJSON(annotations, ProfileDefaultsKey)
+	if err != nil {
+		return err
+	}
+	previousApplied, err := annotationJSON(annotations, ProfileAppliedDefaultsKey)
+	if err != nil {
+		return err
+	}
+	if len(defaults) == 0 && len(previousApplied) == 0 {
+		return nil
+	}
+
+	explicit, err := tc.explicitSpec(ctx)
+	if err != nil {
+		return err
+	}
+	applied := resolveDefaults(explicit, previous, previousApplied, defaults)
+
+	raw, err := json.Marshal(explicit)
+	if err != nil {
+		return err
+	}
+	merged := TektonConfigSpec{}
+	if err := json.Unmarshal(raw, &merged); err != nil {
+		return fmt.Errorf("failed to apply the profile defaults: %w", err)
+	}
+	tc.Spec = merged
+
+	key, err := profile.defaultsKey()
+	if err != nil {
+		return err
+	}
+	appliedRaw, err := json.Marshal(applied)
+	if err != nil {
+		return err
+	}
+	tc.setAnnotation(ProfileDefaultsKey, key)
+	if len(applied) == 0 {
+		appliedRaw = nil
+	}
+	tc.setAnnotation(ProfileAppliedDefaultsKey, string(appliedRaw))
+	return nil
+}
+
+// explicitSpec returns the spec as sent in the admission request, the typed spec drops the
+// fields set to their zero value
+func (tc *TektonConfig) explicitSpec(ctx context.Context) (map[string]interface{}, error) {
+	var spec map[string]interface{}
+	if raw := requestObjectFrom(ctx); raw != nil {
+		object := struct {
+			Spec map[string]interface{} `json:"spec"`
+		}{}
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return nil, err
+		}
+		spec = object.Spec
+	} else {
+		raw, err := json.Marshal(tc.Spec)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &spec); err != nil {
+			return nil, err
+		}
+		// without the request, a field set to its zero value can not be told from an unset one
+		for _, field := range jsonFields(spec, nil) {
+			if isZeroJSON(field.value) {
+				deleteJSONField(spec, field.path)
+			}
+		}
+	}
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	// the profile may have been defaulted
+	spec["profile"] = tc.Spec.Profile
+	return spec, nil
+}
+
+func annotationJSON(annotations map[string]string, key string) (map[string]interface{}, error) {
+	value := map[string]interface{}{}
+	if raw := annotations[key]; raw != "" {
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			return nil, fmt.Errorf("failed to parse the %s annotation: %w", key, err)
+		}
+	}
+	return value, nil
+}
+
+func (tc *TektonConfig) setAnnotation(key, value string) {
+	annotations := tc.GetAnnotations()
+	if value == "" {
+		if _, ok := annotations[key]; ok {
+			delete(annotations, key)
+			tc.SetAnnotations(annotations)
+		}
+		return
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	tc.SetAnnotations(annotations)
+}
+
+// resolveDefaults merges the defaults under the explicit spec and returns the fields taken
+// from the defaults. A field present in the explicit spec is kept, whatever its value, unless
+// it was previously taken from the profile and still holds that value: it then takes the new
+// default, or is removed when the profile no longer defaults it. A previous default which was
+// not taken has been overridden, the field is kept even when its zero value was dropped.
+// The maps are merged key by key.
+func resolveDefaults(explicit, previous, previousApplied, defaults map[string]interface{}) map[string]interface{} {
+	inherited := func(path []string, value interface{}) bool {
+		current, found := getJSONField(explicit, path)
+		if !found {
+			// a field set to its zero value is dropped from the stored object
+			return isZeroJSON(value)
+		}
+		return reflect.DeepEqual(current, value)
+	}
+
+	for _, field := range jsonFields(previousApplied, nil) {
+		if _, found := getJSONField(defaults, field.path); found {
+			continue
+		}
+		if inherited(field.path, field.value) {
+			deleteJSONField(explicit, field.path)
+		}
+	}
+
+	applied := map[string]interface{}{}
+	for _, field := range jsonFields(defaults, nil) {
+		if value, found := getJSONField(previousApplied, field.path); found {
+			if !inherited(field.path, value) {
+				continue
+			}
+		} else if _, found := getJSONField(previous, field.path); found {
+			continue
+		} else if _, found := getJSONField(explicit, field.path); found {
+			continue
+		}
+		setJSONField(explicit, field.path, field.value)
+		setJSONField(applied, field.path, field.value)
+	}
+	return applied
+}
+
+type jsonField struct {
+	path  []string
+	value interface{}
+}
+
+// jsonFields returns the leaves of the object, an empty object is a leaf
+func jsonFields(object map[string]interface{}, prefix []string) []jsonField {
+	fields := []jsonField{}
+	for key, value := range object {
+		path := append(append([]string{}, prefix...), key)
+		if child, ok := value.(map[string]interface{}); ok && len(child) > 0 {
+			fields = append(fields, jsonFields(child, path)...)
+			continue
+		}
+		if value != nil {
+			fields = append(fields, jsonField{path: path, value: value})
+		}
+	}
+	return fields
+}
+
+func getJSONField(object map[string]interface{}, path []string) (interface{}, bool) {
+	var value interface{} = object
+	for _, key := range path {
+		parent, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value = parent[key]
+	}
+	return value, value != nil
+}
+
+func setJSONField(object map[string]interface{}, path []string, value interface{}) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			object[key] = child
+		}
+		object = child
+	}
+	object[path[len(path)-1]] = value
+}
+
+func deleteJSONField(object map[string]interface{}, path []string) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			return
+		}
+		object = child
+	}
+	delete(object, path[len(path)-1])
+}
+
+func isZeroJSON(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
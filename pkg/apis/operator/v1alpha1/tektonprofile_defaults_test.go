@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+)
+
+func TestTektonConfigSetDefaults_TektonProfile(t *testing.T) {
+	profile := &TektonProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci-minimal"},
+		Spec: TektonProfileSpec{
+			Components: []string{ComponentPipeline, ComponentResult},
+			Defaults: &runtime.RawExtension{Raw: []byte(`{
+				"targetNamespace": "ci",
+				"config": {"nodeSelector": {"pool": "ci"}},
+				"pipeline": {"enable-api-fields": "alpha", "default-service-account": "ci"},
+				"result": {"disabled": true}
+			}`)},
+		},
+	}
+	ctx := WithProfileGetter(apis.WithinCreate(context.TODO()), func(context.Context, string) (*TektonProfile, error) {
+		return profile, nil
+	})
+
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigResourceName},
+		Spec: TektonConfigSpec{
+			Profile: "ci-minimal",
+			Config:  Config{NodeSelector: map[string]string{"zone": "a"}},
+		},
+	}
+	tc.Spec.Pipeline.EnableApiFields = "beta"
+	tc.SetDefaults(ctx)
+
+	// the explicit settings win, the unset ones take the profile defaults
+	assert.Equal(t, tc.Spec.TargetNamespace, "ci")
+	assert.DeepEqual(t, tc.Spec.Config.NodeSelector, map[string]string{"zone": "a", "pool": "ci"})
+	assert.Equal(t, tc.Spec.Pipeline.EnableApiFields, "beta")
+	assert.Equal(t, tc.Spec.Pipeline.DefaultServiceAccount, "ci")
+	assert.Equal(t, tc.Spec.Result.Disabled, true)
+	assert.Equal(t, tc.Spec.Profile, "ci-minimal")
+	assert.Assert(t, tc.HasProfileDefaults(profile.Spec))
+
+	// the builtin profiles are not resolved
+	tc = &TektonConfig{ObjectMeta: metav1.ObjectMeta{Name: ConfigResourceName}, Spec: TektonConfigSpec{Profile: ProfileLite}}
+	tc.SetDefaults(ctx)
+	assert.Equal(t, tc.Spec.TargetNamespace, "")
+}
+
+func TestTektonConfigSetDefaults_TektonProfileExplicitZero(t *testing.T) {
+	profile := &TektonProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci-minimal"},
+		Spec: TektonProfileSpec{
+			Components: []string{ComponentPipeline, ComponentResult},
+			Defaults:   &runtime.RawExtension{Raw: []byte(`{"result": {"disabled": true}, "pipeline": {"default-service-account": "ci"}}`)},
+		},
+	}
+	ctx := WithProfileGetter(apis.WithinCreate(context.TODO()), func(context.Context, string) (*TektonProfile, error) {
+		return profile, nil
+	})
+	// the zero values present in the request win over the profile
+	ctx = WithRequestObject(ctx, []byte(`{"spec": {"profile": "ci-minimal", "result": {"disabled": false}}}`))
+
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigResourceName},
+		Spec:       TektonConfigSpec{Profile: "ci-minimal"},
+	}
+	tc.SetDefaults(ctx)
+	assert.Equal(t, tc.Spec.Result.Disabled, false)
+	assert.Equal(t, tc.Spec.Pipeline.DefaultServiceAccount, "ci")
+	assert.Equal(t, tc.GetAnnotations()[ProfileAppliedDefaultsKey], `{"pipeline":{"default-service-account":"ci"}}`)
+
+	// the override is kept when the profile is edited, without the zero value in the request
+	stored := tc.DeepCopy()
+	profile.Spec.Defaults = &runtime.RawExtension{Raw: []byte(`{"result": {"disabled": true}, "pipeline": {"default-service-account": "tekton"}}`)}
+	tc.SetDefaults(WithProfileGetter(apis.WithinUpdate(context.TODO(), stored), func(context.Context, string) (*TektonProfile, error) {
+		return profile, nil
+	}))
+	assert.Equal(t, tc.Spec.Result.Disabled, false)
+	assert.Equal(t, tc.Spec.Pipeline.DefaultServiceAccount, "tekton")
+}
+
+func TestTektonConfigSetDefaults_TektonProfileUpdated(t *testing.T) {
+	profile := &TektonProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci-minimal"},
+		Spec: TektonProfileSpec{
+			Components: []string{ComponentPipeline, ComponentResult},
+			Defaults: &runtime.RawExtension{Raw: []byte(`{
+				"targetNamespace": "ci",
+				"pipeline": {"default-service-account": "ci"},
+				"result": {"disabled": true}
+			}`)},
+		},
+	}
+	getter := func(context.Context, string) (*TektonProfile, error) {
+		return profile, nil
+	}
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigResourceName},
+		Spec:       TektonConfigSpec{Profile: "ci-minimal"},
+	}
+	tc.SetDefaults(WithProfileGetter(apis.WithinCreate(context.TODO()), getter))
+	assert.Equal(t, tc.Spec.TargetNamespace, "ci")
+	assert.Equal(t, tc.Spec.Result.Disabled, true)
+
+	// the user overrides a default, the profile is then edited
+	stored := tc.DeepCopy()
+	tc.Spec.Pipeline.DefaultServiceAccount = "builder"
+	profile.Spec.Defaults = &runtime.RawExtension{Raw: []byte(`{
+		"targetNamespace": "tekton",
+		"pipeline": {"default-service-account": "tekton"}
+	}`)}
+	assert.Assert(t, !tc.HasProfileDefaults(profile.Spec))
+	tc.SetDefaults(WithProfileGetter(apis.WithinUpdate(context.TODO(), stored), getter))
+
+	// the inherited fields follow the profile, the explicit ones are kept
+	assert.Equal(t, tc.Spec.TargetNamespace, "tekton")
+	assert.Equal(t, tc.Spec.Pipeline.DefaultServiceAccount, "builder")
+	assert.Equal(t, tc.Spec.Result.Disabled, false)
+	assert.Assert(t, tc.HasProfileDefaults(profile.Spec))
+
+	// a builtin profile removes the inherited fields
+	stored = tc.DeepCopy()
+	tc.Spec.Profile = ProfileBasic
+	tc.SetDefaults(WithProfileGetter(apis.WithinUpdate(context.TODO(), stored), getter))
+	assert.Equal(t, tc.Spec.TargetNamespace, "")
+	assert.Equal(t, tc.Spec.Pipeline.DefaultServiceAccount, "builder")
+	_, found := tc.GetAnnotations()[ProfileAppliedDefaultsKey]
+	assert.Assert(t, !found)
+}
+
+func TestBuiltinProfile(t *testing.T) {
+	lite, ok := BuiltinProfile(ProfileLite)
+	assert.Assert(t, ok)
+	assert.Assert(t, lite.Enables(ComponentPipeline))
+	assert.Assert(t, !lite.Enables(ComponentTrigger))
+
+	all, _ := BuiltinProfile(ProfileAll)
+	assert.Assert(t, all.Enables(ComponentDashboard))
+
+	_, ok = BuiltinProfile("ci-minimal")
+	assert.Assert(t, !ok)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Components which can be enabled by a profile, the job based pruner is enabled by
+// spec.pruner of the TektonConfig
+const (
+	ComponentPipeline     = "pipeline"
+	ComponentTrigger      = "trigger"
+	ComponentChain        = "chain"
+	ComponentResult       = "result"
+	ComponentTektonPruner = "tektonpruner"
+	ComponentDashboard    = "dashboard"
+	ComponentAddon        = "addon"
+)
+
+var (
+	// ProfileComponents are the components a TektonProfile can enable
+	ProfileComponents = []string{
+		ComponentPipeline,
+		ComponentTrigger,
+		ComponentChain,
+		ComponentResult,
+		ComponentTektonPruner,
+		ComponentDashboard,
+		ComponentAddon,
+	}
+
+	// builtinProfiles are the components enabled by the lite, basic and all profiles
+	builtinProfiles = map[string]TektonProfileSpec{
+		ProfileLite: {Components: []string{
+			ComponentPipeline, ComponentChain, ComponentResult, ComponentTektonPruner,
+		}},
+		ProfileBasic: {Components: []string{
+			ComponentPipeline, ComponentTrigger, ComponentChain, ComponentResult, ComponentTektonPruner,
+		}},
+		ProfileAll: {Components: []string{
+			ComponentPipeline, ComponentTrigger, ComponentChain, ComponentResult, ComponentTektonPruner,
+			ComponentDashboard, ComponentAddon,
+		}},
+	}
+)
+
+// TektonProfile declares an installation profile, a TektonConfig referencing it by name in
+// spec.profile installs the components of the profile
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster
+type TektonProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TektonProfileSpec `json:"spec,omitempty"`
+}
+
+// TektonProfileSpec defines the components and the defaults of a profile
+type TektonProfileSpec struct {
+	// Components are the components installed by the profile, pipeline is required
+	Components []string `json:"components"`
+	// Defaults is a fragment of the TektonConfig spec, a field of the TektonConfig
+	// left unset takes the value of the profile
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Defaults *runtime.RawExtension `json:"defaults,omitempty"`
+}
+
+// TektonProfileList contains a list of TektonProfile
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TektonProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TektonProfile `json:"items"`
+}
+
+// IsBuiltinProfile returns true for the lite, basic and all profiles
+func IsBuiltinProfile(profile string) bool {
+	_, ok := builtinProfiles[profile]
+	return ok
+}
+
+// BuiltinProfile returns the spec of a builtin profile
+func BuiltinProfile(profile string) (TektonProfileSpec, bool) {
+	spec, ok := builtinProfiles[profile]
+	return spec, ok
+}
+
+// Enables returns true if the profile installs the component
+func (ps TektonProfileSpec) Enables(component string) bool {
+	return isValueInArray(ps.Components, component)
+}
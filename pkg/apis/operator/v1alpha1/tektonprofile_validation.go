@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+func (tp *TektonProfile) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if apis.IsInDelete(ctx) {
+		return nil
+	}
+
+	// spec.profile resolves the builtin profiles first
+	if IsBuiltinProfile(tp.GetName()) {
+		errs = errs.Also(apis.ErrInvalidValue(tp.GetName(), "metadata.name, the name of a builtin profile is reserved"))
+	}
+
+	return errs.Also(tp.Spec.validate("spec"))
+}
+
+func (ps *TektonProfileSpec) validate(path string) (errs *apis.FieldError) {
+	if !ps.Enables(ComponentPipeline) {
+		errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("%s is required, every component depends on it", ComponentPipeline), path+".components"))
+	}
+	for i, component := range ps.Components {
+		if !isValueInArray(ProfileComponents, component) {
+			err := apis.ErrInvalidArrayValue(component, path+".components", i)
+			err.Details = fmt.Sprintf("supported components: %s", strings.Join(ProfileComponents, ", "))
+			errs = errs.Also(err)
+		}
+	}
+
+	if ps.Defaults == nil || len(ps.Defaults.Raw) == 0 {
+		return errs
+	}
+	defaults := map[string]interface{}{}
+	if err := json.Unmarshal(ps.Defaults.Raw, &defaults); err != nil {
+		return errs.Also(apis.ErrGeneric(err.Error(), path+".defaults"))
+	}
+	if _, ok := defaults["profile"]; ok {
+		errs = errs.Also(apis.ErrDisallowedFields(path + ".defaults.profile"))
+	}
+	// reject the unknown fields, a typo would otherwise be silently dropped
+	decoder := json.NewDecoder(bytes.NewReader(ps.Defaults.Raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&TektonConfigSpec{}); err != nil {
+		errs = errs.Also(apis.ErrGeneric(err.Error(), path+".defaults"))
+	}
+	return errs
+}
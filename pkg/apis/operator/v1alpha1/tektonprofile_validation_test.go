@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTektonProfileValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+		spec    TektonProfileSpec
+		err     string
+	}{
+		{
+			name:    "valid",
+			profile: "ci-minimal",
+			spec: TektonProfileSpec{
+				Components: []string{ComponentPipeline, ComponentResult},
+				Defaults:   &runtime.RawExtension{Raw: []byte(`{"pipeline":{"enable-api-fields":"beta"}}`)},
+			},
+		},
+		{
+			name:    "builtin name",
+			profile: ProfileAll,
+			spec:    TektonProfileSpec{Components: []string{ComponentPipeline}},
+			err:     "invalid value: all: metadata.name, the name of a builtin profile is reserved",
+		},
+		{
+			name:    "without pipeline",
+			profile: "results",
+			spec:    TektonProfileSpec{Components: []string{ComponentResult}},
+			err:     "pipeline is required, every component depends on it: spec.components",
+		},
+		{
+			name:    "unknown component",
+			profile: "hub",
+			spec:    TektonProfileSpec{Components: []string{ComponentPipeline, "hub"}},
+			err:     "invalid value: hub: spec.components[1]\nsupported components: pipeline, trigger, chain, result, tektonpruner, dashboard, addon",
+		},
+		{
+			name:    "defaults with profile",
+			profile: "nested",
+			spec: TektonProfileSpec{
+				Components: []string{ComponentPipeline},
+				Defaults:   &runtime.RawExtension{Raw: []byte(`{"profile":"all"}`)},
+			},
+			err: "must not set the field(s): spec.defaults.profile",
+		},
+		{
+			name:    "defaults with unknown field",
+			profile: "typo",
+			spec: TektonProfileSpec{
+				Components: []string{ComponentPipeline},
+				Defaults:   &runtime.RawExtension{Raw: []byte(`{"pipelines":{}}`)},
+			},
+			err: `json: unknown field "pipelines": spec.defaults`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tp := &TektonProfile{ObjectMeta: metav1.ObjectMeta{Name: test.profile}, Spec: test.spec}
+			err := tp.Validate(context.TODO())
+			if test.err == "" {
+				assert.Assert(t, err == nil, err)
+				return
+			}
+			assert.Error(t, err, test.err)
+		})
+	}
+}
+
+func TestTektonConfigValidate_TektonProfile(t *testing.T) {
+	tc := &TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigResourceName},
+		Spec: TektonConfigSpec{
+			Profile:    "ci-minimal",
+			CommonSpec: CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:     Prune{Disabled: true},
+		},
+	}
+
+	// a TektonProfile can only be resolved by the webhook
+	assert.Error(t, tc.Validate(context.TODO()), "invalid value: ci-minimal: spec.profile")
+
+	ctx := WithProfileGetter(context.TODO(), func(_ context.Context, name string) (*TektonProfile, error) {
+		if name != "ci-minimal" {
+			return nil, apierrs.NewNotFound(Resource("tektonprofiles"), name)
+		}
+		return &TektonProfile{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+	})
+	assert.Assert(t, tc.Validate(ctx) == nil)
+
+	tc.Spec.Profile = "missing"
+	assert.ErrorContains(t, tc.Validate(ctx), `failed to get the TektonProfile: tektonprofiles.operator.tekton.dev "missing" not found`)
+}
@@ -25,6 +25,7 @@ func (tp *TektonResult) SetDefaults(ctx context.Context) {
 	if tp.Spec.TLSHostnameOverride != "" {
 		tp.Spec.TLSHostnameOverride = ""
 	}
+	tp.Spec.DBBackup.setDefaults()
 }
 
 // Sets default values of Result
@@ -39,4 +40,6 @@ func (c *Result) setDefaults() {
 	if c.RouteTLSTermination == "" {
 		c.RouteTLSTermination = "edge"
 	}
+
+	c.DBBackup.setDefaults()
 }
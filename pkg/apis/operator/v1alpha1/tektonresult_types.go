@@ -75,6 +75,54 @@ type Result struct {
 	Options AdditionalOptions `json:"options"`
 	// +optional
 	Performance PerformanceProperties `json:"performance,omitempty"`
+	// DBBackup schedules backups of the operator managed database
+	// +optional
+	DBBackup *DBBackup `json:"db_backup,omitempty"`
+	// Retention configures the retention policy agent pruning the records and logs
+	// +optional
+	Retention *ResultsRetention `json:"retention,omitempty"`
+	// S3 configures the S3 compatible object storage of the logs, used with logs_type S3
+	// +optional
+	S3 *S3LogsProperties `json:"s3,omitempty"`
+}
+
+// S3LogsProperties defines the S3 compatible object storage the Results API stores the logs in
+type S3LogsProperties struct {
+	// Endpoint is the URL of the object storage, the AWS endpoint of the region is used if empty
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	Bucket   string `json:"bucket"`
+	Region   string `json:"region"`
+	// ForcePathStyle addresses the bucket in the path of the URL instead of the host name,
+	// required by most S3 compatible storages like MinIO
+	// +optional
+	ForcePathStyle *bool `json:"force_path_style,omitempty"`
+	// MultiPartSize is the size, in bytes, of the parts of the multipart uploads
+	// +optional
+	MultiPartSize *int64 `json:"multi_part_size,omitempty"`
+	// CredentialsSecretName is the name of a secret in the target namespace holding the
+	// S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY keys. The credentials of the environment,
+	// e.g. the service account role, are used if empty
+	// +optional
+	CredentialsSecretName string `json:"credentials_secret_name,omitempty"`
+	// CASecretName is the name of a secret in the target namespace holding the CA bundle
+	// used to verify the certificate of the endpoint
+	// +optional
+	CASecretName string `json:"ca_secret_name,omitempty"`
+	// CASecretKey is the key of the CA bundle in the CA secret
+	// +optional
+	CASecretKey string `json:"ca_secret_key,omitempty"`
+}
+
+// ResultsRetention defines the retention policy of the records and logs, the fields
+// are rendered into the ConfigMap of the retention policy agent
+type ResultsRetention struct {
+	// RunAt is the cron schedule of the prune runs
+	// +optional
+	RunAt string `json:"runAt,omitempty"`
+	// MaxRetention is the maximum age, in days, of the records and logs
+	// +optional
+	MaxRetention *uint `json:"maxRetention,omitempty"`
 }
 
 // ResultsAPIProperties defines the fields which are configurable for
@@ -141,6 +189,10 @@ type TektonResultStatus struct {
 	// The current installer set name for TektonResult
 	// +optional
 	TektonInstallerSet string `json:"tektonInstallerSet,omitempty"`
+
+	// The last backup and restore of the operator managed database
+	// +optional
+	DBBackup *DBBackupStatus `json:"dbBackup,omitempty"`
 }
 
 func (trs *TektonResultStatus) MarkPreReconcilerFailed(msg string) {
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"knative.dev/pkg/apis"
@@ -26,6 +27,10 @@ import (
 
 const (
 	LogsTypeLoki = "loki"
+	LogsTypeS3   = "S3"
+
+	// minimum size of the parts of a multipart upload accepted by S3
+	s3MinMultiPartSize = 5 * 1024 * 1024
 )
 
 func (tp *TektonResult) Validate(ctx context.Context) (errs *apis.FieldError) {
@@ -57,5 +62,60 @@ func (trs *TektonResultSpec) validate(path string) (errs *apis.FieldError) {
 	// validate performance properties
 	errs = errs.Also(trs.Performance.Validate(fmt.Sprintf("%s.performance", path)))
 
+	errs = errs.Also(trs.validateDBBackup(path))
+	errs = errs.Also(trs.Retention.validate(path + ".retention"))
+	errs = errs.Also(trs.validateS3Logs(path))
+
+	return errs
+}
+
+func (r *ResultsRetention) validate(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	if r == nil {
+		return errs
+	}
+	if r.RunAt != "" && !isValidCronSchedule(r.RunAt) {
+		errs = errs.Also(apis.ErrInvalidValue(r.RunAt, path+".runAt"))
+	}
+	if r.MaxRetention != nil && *r.MaxRetention == 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*r.MaxRetention, path+".maxRetention"))
+	}
+	return errs
+}
+
+// validateS3Logs validates the typed S3 logs configuration, it replaces the free form secret_name
+func (r *Result) validateS3Logs(path string) *apis.FieldError {
+	var errs *apis.FieldError
+	s3 := r.S3
+	if s3 == nil {
+		return errs
+	}
+	s3Path := path + ".s3"
+
+	if r.LogsType != LogsTypeS3 {
+		errs = errs.Also(apis.ErrInvalidValue(r.LogsType, path+".logs_type", fmt.Sprintf("s3 requires logs_type %s", LogsTypeS3)))
+	}
+	if r.SecretName != "" {
+		errs = errs.Also(apis.ErrMultipleOneOf(path+".secret_name", s3Path))
+	}
+
+	if s3.Bucket == "" {
+		errs = errs.Also(apis.ErrMissingField(s3Path + ".bucket"))
+	}
+	if s3.Region == "" {
+		errs = errs.Also(apis.ErrMissingField(s3Path + ".region"))
+	}
+	if s3.Endpoint != "" {
+		endpoint, err := url.Parse(s3.Endpoint)
+		if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+			errs = errs.Also(apis.ErrInvalidValue(s3.Endpoint, s3Path+".endpoint", "endpoint must be an http or https URL"))
+		}
+	}
+	if s3.MultiPartSize != nil && *s3.MultiPartSize < s3MinMultiPartSize {
+		errs = errs.Also(apis.ErrInvalidValue(*s3.MultiPartSize, s3Path+".multi_part_size", fmt.Sprintf("multi_part_size must be at least %d bytes", s3MinMultiPartSize)))
+	}
+	if (s3.CASecretName == "") != (s3.CASecretKey == "") {
+		errs = errs.Also(apis.ErrGeneric("ca_secret_name and ca_secret_key must be set together", s3Path+".ca_secret_name", s3Path+".ca_secret_key"))
+	}
 	return errs
 }
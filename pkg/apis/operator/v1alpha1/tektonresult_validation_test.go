@@ -113,3 +113,82 @@ func TestTektonResultWatcherPerformancePropertiesValidate(t *testing.T) {
 		"spec.performance.replicas must equal spec.performance.buckets for statefulset ordinals"
 	assert.Equal(t, expectedErrorMessage, errs.Error())
 }
+
+func TestTektonResultRetentionValidate(t *testing.T) {
+	zero := uint(0)
+	days := uint(30)
+	tests := []struct {
+		name      string
+		retention *ResultsRetention
+		err       string
+	}{
+		{name: "not set"},
+		{name: "valid", retention: &ResultsRetention{RunAt: "7 7 * * 7", MaxRetention: &days}},
+		{
+			name:      "invalid",
+			retention: &ResultsRetention{RunAt: "weekly", MaxRetention: &zero},
+			err:       "invalid value: 0: spec.retention.maxRetention\ninvalid value: weekly: spec.retention.runAt",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr := &TektonResult{
+				ObjectMeta: metav1.ObjectMeta{Name: ResultResourceName},
+				Spec:       TektonResultSpec{Result: Result{Retention: test.retention}},
+			}
+			err := tr.Validate(context.TODO())
+			if test.err == "" {
+				assert.Assert(t, err == nil, "unexpected error: %v", err)
+				return
+			}
+			assert.Equal(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestTektonResultS3LogsValidate(t *testing.T) {
+	partSize := int64(1024)
+	tests := []struct {
+		name   string
+		result Result
+		err    string
+	}{
+		{
+			name: "valid",
+			result: Result{
+				ResultsAPIProperties: ResultsAPIProperties{LogsType: LogsTypeS3},
+				S3: &S3LogsProperties{
+					Endpoint: "http://minio.minio.svc:9000", Bucket: "logs", Region: "us-east-1",
+					CredentialsSecretName: "s3-credentials", CASecretName: "s3-ca", CASecretKey: "ca.crt",
+				},
+			},
+		},
+		{
+			name: "invalid",
+			result: Result{
+				ResultsAPIProperties: ResultsAPIProperties{LogsType: "File", SecretName: "s3-secret"},
+				S3:                   &S3LogsProperties{Endpoint: "minio:9000", MultiPartSize: &partSize, CASecretName: "s3-ca"},
+			},
+			err: "ca_secret_name and ca_secret_key must be set together: spec.s3.ca_secret_key, spec.s3.ca_secret_name\n" +
+				"expected exactly one, got both: spec.s3, spec.secret_name\n" +
+				"invalid value: 1024: spec.s3.multi_part_size\nmulti_part_size must be at least 5242880 bytes\n" +
+				"invalid value: File: spec.logs_type\ns3 requires logs_type S3\n" +
+				"invalid value: minio:9000: spec.s3.endpoint\nendpoint must be an http or https URL\n" +
+				"missing field(s): spec.s3.bucket, spec.s3.region",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr := &TektonResult{
+				ObjectMeta: metav1.ObjectMeta{Name: ResultResourceName},
+				Spec:       TektonResultSpec{Result: test.result},
+			}
+			err := tr.Validate(context.TODO())
+			if test.err == "" {
+				assert.Assert(t, err == nil, "unexpected error: %v", err)
+				return
+			}
+			assert.Equal(t, err.Error(), test.err)
+		})
+	}
+}
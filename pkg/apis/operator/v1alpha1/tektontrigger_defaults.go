@@ -18,8 +18,10 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	"github.com/tektoncd/triggers/pkg/apis/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -27,6 +29,14 @@ var (
 	DefaultOpenshiftSA = "pipeline"
 )
 
+const (
+	// EventListenerTLSDefaultDuration is the duration of the EventListener certificates when not set
+	EventListenerTLSDefaultDuration = 90 * 24 * time.Hour
+	// CertManagerClusterIssuer and CertManagerIssuer are the kinds of cert-manager issuers
+	CertManagerClusterIssuer = "ClusterIssuer"
+	CertManagerIssuer        = "Issuer"
+)
+
 func (tt *TektonTrigger) SetDefaults(ctx context.Context) {
 	tt.Spec.Trigger.setDefaults()
 }
@@ -36,12 +46,26 @@ func (t *Trigger) setDefaults() {
 		t.EnableApiFields = config.DefaultEnableAPIFields
 	}
 
+	t.EventListenerTLS.setDefaults()
+
 	// run platform specific defaulting
 	if IsOpenShiftPlatform() {
 		t.openshiftDefaulting()
 	}
 }
 
+func (e *EventListenerTLS) setDefaults() {
+	if e == nil {
+		return
+	}
+	if e.Duration == nil {
+		e.Duration = &metav1.Duration{Duration: EventListenerTLSDefaultDuration}
+	}
+	if e.CertManager != nil && e.CertManager.Kind == "" {
+		e.CertManager.Kind = CertManagerClusterIssuer
+	}
+}
+
 func (t *Trigger) openshiftDefaulting() {
 	if t.DefaultServiceAccount == "" {
 		t.DefaultServiceAccount = DefaultOpenshiftSA
@@ -64,6 +64,11 @@ type TektonTriggerStatus struct {
 	// The current installer set name
 	// +optional
 	TektonInstallerSet string `json:"tektonInstallerSet,omitempty"`
+
+	// True once the EventListeners are served with the certificates of eventListenerTLS,
+	// the certificates are removed when eventListenerTLS is unset
+	// +optional
+	EventListenerTLS bool `json:"eventListenerTLS,omitempty"`
 }
 
 // TektonTriggersList contains a list of TektonTrigger
@@ -81,6 +86,31 @@ type Trigger struct {
 	TriggersProperties `json:",inline"`
 	// options holds additions fields and these fields will be updated on the manifests
 	Options AdditionalOptions `json:"options"`
+	// EventListenerTLS serves the EventListeners of the selected namespaces with TLS on Kubernetes,
+	// on OpenShift the certificates are issued by the service CA
+	// +optional
+	EventListenerTLS *EventListenerTLS `json:"eventListenerTLS,omitempty"`
+}
+
+// EventListenerTLS configures the serving certificates of the EventListeners
+type EventListenerTLS struct {
+	// NamespaceSelector selects the namespaces of the EventListeners, an empty selector selects all namespaces
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
+	// CertManager issues the certificates with cert-manager,
+	// the certificates are signed by an operator managed CA when not set
+	// +optional
+	CertManager *CertManagerIssuerRef `json:"certManager,omitempty"`
+	// Duration of the certificates, they are renewed when a third of the duration remains
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// CertManagerIssuerRef references the cert-manager issuer of the certificates
+type CertManagerIssuerRef struct {
+	Name string `json:"name"`
+	// Kind is either Issuer, in the namespace of the EventListener, or ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
 }
 
 // TriggersProperties defines the fields which are to be
@@ -20,10 +20,16 @@ import (
 	"context"
 	"fmt"
 
+	"time"
+
 	"github.com/tektoncd/triggers/pkg/apis/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 )
 
+// eventListenerTLSMinDuration is the minimum duration of the EventListener certificates
+const eventListenerTLSMinDuration = time.Hour
+
 func (tr *TektonTrigger) Validate(ctx context.Context) (errs *apis.FieldError) {
 
 	if apis.IsInDelete(ctx) {
@@ -38,6 +44,8 @@ func (tr *TektonTrigger) Validate(ctx context.Context) (errs *apis.FieldError) {
 	// execute common spec validations
 	errs = errs.Also(tr.Spec.CommonSpec.validate("spec"))
 
+	errs = errs.Also(tr.Spec.EventListenerTLS.validate("spec.eventListenerTLS"))
+
 	return errs.Also(tr.Spec.TriggersProperties.validate("spec"))
 }
 
@@ -50,3 +58,32 @@ func (tr *TriggersProperties) validate(path string) (errs *apis.FieldError) {
 	}
 	return errs
 }
+
+func (e *EventListenerTLS) validate(path string) (errs *apis.FieldError) {
+	if e == nil {
+		return nil
+	}
+	if IsOpenShiftPlatform() {
+		return apis.ErrGeneric("eventListenerTLS is not supported on OpenShift, the certificates are issued by the service CA", path)
+	}
+
+	if e.NamespaceSelector == nil {
+		errs = errs.Also(apis.ErrMissingField(path + ".namespaceSelector"))
+	} else if _, err := metav1.LabelSelectorAsSelector(e.NamespaceSelector); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(err.Error(), path+".namespaceSelector"))
+	}
+
+	if e.CertManager != nil {
+		if e.CertManager.Name == "" {
+			errs = errs.Also(apis.ErrMissingField(path + ".certManager.name"))
+		}
+		if e.CertManager.Kind != "" && e.CertManager.Kind != CertManagerIssuer && e.CertManager.Kind != CertManagerClusterIssuer {
+			errs = errs.Also(apis.ErrInvalidValue(e.CertManager.Kind, path+".certManager.kind"))
+		}
+	}
+
+	if e.Duration != nil && e.Duration.Duration < eventListenerTLSMinDuration {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s, must be at least %s", e.Duration.Duration, eventListenerTLSMinDuration), path+".duration"))
+	}
+	return errs
+}
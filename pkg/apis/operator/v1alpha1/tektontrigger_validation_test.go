@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -81,3 +82,82 @@ func Test_ValidateTektonTrigger_OnDelete(t *testing.T) {
 		t.Errorf("ValidateTektonTrigger.Validate() on Delete expected no error, but got one, ValidateTektonTrigger: %v", err)
 	}
 }
+
+func Test_ValidateTektonTrigger_EventListenerTLS(t *testing.T) {
+	tests := []struct {
+		name      string
+		tls       *EventListenerTLS
+		openshift bool
+		err       string
+	}{
+		{
+			name: "operator managed CA",
+			tls: &EventListenerTLS{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ci"}},
+				Duration:          &metav1.Duration{Duration: 24 * time.Hour},
+			},
+		},
+		{
+			name: "cert-manager issuer",
+			tls: &EventListenerTLS{
+				NamespaceSelector: &metav1.LabelSelector{},
+				CertManager:       &CertManagerIssuerRef{Name: "letsencrypt", Kind: CertManagerIssuer},
+			},
+		},
+		{
+			name: "missing namespace selector",
+			tls:  &EventListenerTLS{},
+			err:  "missing field(s): spec.eventListenerTLS.namespaceSelector",
+		},
+		{
+			name: "invalid cert-manager issuer",
+			tls: &EventListenerTLS{
+				NamespaceSelector: &metav1.LabelSelector{},
+				CertManager:       &CertManagerIssuerRef{Kind: "Certificate"},
+			},
+			err: "invalid value: Certificate: spec.eventListenerTLS.certManager.kind\nmissing field(s): spec.eventListenerTLS.certManager.name",
+		},
+		{
+			name: "short duration",
+			tls: &EventListenerTLS{
+				NamespaceSelector: &metav1.LabelSelector{},
+				Duration:          &metav1.Duration{Duration: time.Minute},
+			},
+			err: "invalid value: 1m0s, must be at least 1h0m0s: spec.eventListenerTLS.duration",
+		},
+		{
+			name:      "openshift",
+			tls:       &EventListenerTLS{NamespaceSelector: &metav1.LabelSelector{}},
+			openshift: true,
+			err:       "eventListenerTLS is not supported on OpenShift, the certificates are issued by the service CA: spec.eventListenerTLS",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.openshift {
+				t.Setenv("PLATFORM", "openshift")
+			}
+			tt := &TektonTrigger{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: TriggerResourceName,
+				},
+				Spec: TektonTriggerSpec{
+					CommonSpec: CommonSpec{
+						TargetNamespace: "tekton-pipelines",
+					},
+					Trigger: Trigger{
+						EventListenerTLS: test.tls,
+					},
+				},
+			}
+
+			err := tt.Validate(context.TODO())
+			if test.err == "" {
+				assert.Assert(t, err == nil)
+			} else {
+				assert.Equal(t, test.err, err.Error())
+			}
+		})
+	}
+}
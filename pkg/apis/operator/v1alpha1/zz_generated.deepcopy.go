@@ -27,6 +27,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -127,6 +129,13 @@ func (in *Addon) DeepCopyInto(out *Addon) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Catalogs != nil {
+		in, out := &in.Catalogs, &out.Catalogs
+		*out = make([]AddonCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -140,6 +149,52 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonCatalog) DeepCopyInto(out *AddonCatalog) {
+	*out = *in
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitCatalogSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bundle != nil {
+		in, out := &in.Bundle, &out.Bundle
+		*out = new(BundleCatalogSource)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapCatalogSource)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URLCatalogSource)
+		**out = **in
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]CatalogFilter, len(*in))
+		copy(*out, *in)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonCatalog.
+func (in *AddonCatalog) DeepCopy() *AddonCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApiSpec) DeepCopyInto(out *ApiSpec) {
 	*out = *in
@@ -156,6 +211,22 @@ func (in *ApiSpec) DeepCopy() *ApiSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BundleCatalogSource) DeepCopyInto(out *BundleCatalogSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleCatalogSource.
+func (in *BundleCatalogSource) DeepCopy() *BundleCatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(BundleCatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Catalog) DeepCopyInto(out *Catalog) {
 	*out = *in
@@ -172,6 +243,22 @@ func (in *Catalog) DeepCopy() *Catalog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogFilter) DeepCopyInto(out *CatalogFilter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogFilter.
+func (in *CatalogFilter) DeepCopy() *CatalogFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Category) DeepCopyInto(out *Category) {
 	*out = *in
@@ -188,6 +275,22 @@ func (in *Category) DeepCopy() *Category {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerRef.
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chain) DeepCopyInto(out *Chain) {
 	*out = *in
@@ -271,6 +374,88 @@ func (in *CommonSpec) DeepCopy() *CommonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentConfigs) DeepCopyInto(out *ComponentConfigs) {
+	*out = *in
+	if in.Pipeline != nil {
+		in, out := &in.Pipeline, &out.Pipeline
+		*out = new(Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Chain != nil {
+		in, out := &in.Chain, &out.Chain
+		*out = new(Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Result != nil {
+		in, out := &in.Result, &out.Result
+		*out = new(Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TektonPruner != nil {
+		in, out := &in.TektonPruner, &out.TektonPruner
+		*out = new(Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pruner != nil {
+		in, out := &in.Pruner, &out.Pruner
+		*out = new(Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dashboard != nil {
+		in, out := &in.Dashboard, &out.Dashboard
+		*out = new(Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addon != nil {
+		in, out := &in.Addon, &out.Addon
+		*out = new(Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PipelinesAsCode != nil {
+		in, out := &in.PipelinesAsCode, &out.PipelinesAsCode
+		*out = new(Config)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentConfigs.
+func (in *ComponentConfigs) DeepCopy() *ComponentConfigs {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentConfigs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Config) DeepCopyInto(out *Config) {
 	*out = *in
@@ -288,6 +473,57 @@ func (in *Config) DeepCopyInto(out *Config) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerSecurityContext != nil {
+		in, out := &in.ContainerSecurityContext, &out.ContainerSecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultResources != nil {
+		in, out := &in.DefaultResources, &out.DefaultResources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(Observability)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -301,6 +537,48 @@ func (in *Config) DeepCopy() *Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapCatalogSource) DeepCopyInto(out *ConfigMapCatalogSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapCatalogSource.
+func (in *ConfigMapCatalogSource) DeepCopy() *ConfigMapCatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapCatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConflictedResource) DeepCopyInto(out *ConflictedResource) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Managers != nil {
+		in, out := &in.Managers, &out.Managers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConflictedResource.
+func (in *ConflictedResource) DeepCopy() *ConflictedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConflictedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomLogoSpec) DeepCopyInto(out *CustomLogoSpec) {
 	*out = *in
@@ -317,6 +595,98 @@ func (in *CustomLogoSpec) DeepCopy() *CustomLogoSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResolver) DeepCopyInto(out *CustomResolver) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]rbacv1.PolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResolver.
+func (in *CustomResolver) DeepCopy() *CustomResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBBackup) DeepCopyInto(out *DBBackup) {
+	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(uint)
+		**out = **in
+	}
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(PVCBackupTarget)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3BackupTarget)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBBackup.
+func (in *DBBackup) DeepCopy() *DBBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBBackupStatus) DeepCopyInto(out *DBBackupStatus) {
+	*out = *in
+	if in.LastSuccessfulBackupTime != nil {
+		in, out := &in.LastSuccessfulBackupTime, &out.LastSuccessfulBackupTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRestoreTime != nil {
+		in, out := &in.LastRestoreTime, &out.LastRestoreTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBBackupStatus.
+func (in *DBBackupStatus) DeepCopy() *DBBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Dashboard) DeepCopyInto(out *Dashboard) {
 	*out = *in
@@ -354,6 +724,11 @@ func (in *DashboardProperties) DeepCopy() *DashboardProperties {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DbSpec) DeepCopyInto(out *DbSpec) {
 	*out = *in
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(DBBackup)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -419,6 +794,102 @@ func (in *DeploymentPerformanceArgs) DeepCopy() *DeploymentPerformanceArgs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedResource) DeepCopyInto(out *DriftedResource) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedResource.
+func (in *DriftedResource) DeepCopy() *DriftedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventListenerTLS) DeepCopyInto(out *EventListenerTLS) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventListenerTLS.
+func (in *EventListenerTLS) DeepCopy() *EventListenerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(EventListenerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitCatalogSource) DeepCopyInto(out *GitCatalogSource) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCatalogSource.
+func (in *GitCatalogSource) DeepCopy() *GitCatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitCatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaDashboards) DeepCopyInto(out *GrafanaDashboards) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboards.
+func (in *GrafanaDashboards) DeepCopy() *GrafanaDashboards {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaDashboards)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Hub) DeepCopyInto(out *Hub) {
 	*out = *in
@@ -441,6 +912,77 @@ func (in *Hub) DeepCopy() *Hub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HubIngressHost) DeepCopyInto(out *HubIngressHost) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HubIngressHost.
+func (in *HubIngressHost) DeepCopy() *HubIngressHost {
+	if in == nil {
+		return nil
+	}
+	out := new(HubIngressHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HubIngressSpec) DeepCopyInto(out *HubIngressSpec) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Api = in.Api
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(HubIngressHost)
+		**out = **in
+	}
+	if in.Ui != nil {
+		in, out := &in.Ui, &out.Ui
+		*out = new(HubIngressHost)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HubIngressSpec.
+func (in *HubIngressSpec) DeepCopy() *HubIngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HubIngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
+	*out = *in
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(PodSecurity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kubernetes.
+func (in *Kubernetes) DeepCopy() *Kubernetes {
+	if in == nil {
+		return nil
+	}
+	out := new(Kubernetes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LokiStackProperties) DeepCopyInto(out *LokiStackProperties) {
 	*out = *in
@@ -570,6 +1112,34 @@ func (in *ManualApprovalGateStatus) DeepCopy() *ManualApprovalGateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Dashboards != nil {
+		in, out := &in.Dashboards, &out.Dashboards
+		*out = new(GrafanaDashboards)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceMetadata) DeepCopyInto(out *NamespaceMetadata) {
 	*out = *in
@@ -600,6 +1170,69 @@ func (in *NamespaceMetadata) DeepCopy() *NamespaceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observability) DeepCopyInto(out *Observability) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(ObservabilityMetrics)
+		**out = **in
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(ObservabilityTracing)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observability.
+func (in *Observability) DeepCopy() *Observability {
+	if in == nil {
+		return nil
+	}
+	out := new(Observability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityMetrics) DeepCopyInto(out *ObservabilityMetrics) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityMetrics.
+func (in *ObservabilityMetrics) DeepCopy() *ObservabilityMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityTracing) DeepCopyInto(out *ObservabilityTracing) {
+	*out = *in
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityTracing.
+func (in *ObservabilityTracing) DeepCopy() *ObservabilityTracing {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityTracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenShift) DeepCopyInto(out *OpenShift) {
 	*out = *in
@@ -781,12 +1414,28 @@ func (in *PACSettings) DeepCopyInto(out *PACSettings) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PACSettings.
-func (in *PACSettings) DeepCopy() *PACSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PACSettings.
+func (in *PACSettings) DeepCopy() *PACSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(PACSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PVCBackupTarget) DeepCopyInto(out *PVCBackupTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVCBackupTarget.
+func (in *PVCBackupTarget) DeepCopy() *PVCBackupTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(PACSettings)
+	out := new(PVCBackupTarget)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -917,6 +1566,38 @@ func (in *Pipeline) DeepCopy() *Pipeline {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PipelineConfigKeys) DeepCopyInto(out *PipelineConfigKeys) {
+	{
+		in := &in
+		*out = make(PipelineConfigKeys, len(*in))
+		for key, val := range *in {
+			var outVal map[string]ConfigValueKind
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]ConfigValueKind, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineConfigKeys.
+func (in PipelineConfigKeys) DeepCopy() PipelineConfigKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineConfigKeys)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineMetricsProperties) DeepCopyInto(out *PipelineMetricsProperties) {
 	*out = *in
@@ -1061,10 +1742,31 @@ func (in *PipelinesAsCode) DeepCopy() *PipelinesAsCode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformReportStatus) DeepCopyInto(out *PlatformReportStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformReportStatus.
+func (in *PlatformReportStatus) DeepCopy() *PlatformReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Platforms) DeepCopyInto(out *Platforms) {
 	*out = *in
 	in.OpenShift.DeepCopyInto(&out.OpenShift)
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
 	return
 }
 
@@ -1078,6 +1780,27 @@ func (in *Platforms) DeepCopy() *Platforms {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurity) DeepCopyInto(out *PodSecurity) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurity.
+func (in *PodSecurity) DeepCopy() *PodSecurity {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Prune) DeepCopyInto(out *Prune) {
 	*out = *in
@@ -1160,6 +1883,11 @@ func (in *Resolvers) DeepCopyInto(out *Resolvers) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EnableHttpResolver != nil {
+		in, out := &in.EnableHttpResolver, &out.EnableHttpResolver
+		*out = new(bool)
+		**out = **in
+	}
 	in.ResolversConfig.DeepCopyInto(&out.ResolversConfig)
 	return
 }
@@ -1205,6 +1933,20 @@ func (in *ResolversConfig) DeepCopyInto(out *ResolversConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.HttpResolverConfig != nil {
+		in, out := &in.HttpResolverConfig, &out.HttpResolverConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CustomResolvers != nil {
+		in, out := &in.CustomResolvers, &out.CustomResolvers
+		*out = make([]CustomResolver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1218,6 +1960,22 @@ func (in *ResolversConfig) DeepCopy() *ResolversConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSelector.
+func (in *ResourceSelector) DeepCopy() *ResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Result) DeepCopyInto(out *Result) {
 	*out = *in
@@ -1225,6 +1983,21 @@ func (in *Result) DeepCopyInto(out *Result) {
 	out.LokiStackProperties = in.LokiStackProperties
 	in.Options.DeepCopyInto(&out.Options)
 	in.Performance.DeepCopyInto(&out.Performance)
+	if in.DBBackup != nil {
+		in, out := &in.DBBackup, &out.DBBackup
+		*out = new(DBBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ResultsRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3LogsProperties)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1314,6 +2087,69 @@ func (in *ResultsAPIProperties) DeepCopy() *ResultsAPIProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsRetention) DeepCopyInto(out *ResultsRetention) {
+	*out = *in
+	if in.MaxRetention != nil {
+		in, out := &in.MaxRetention, &out.MaxRetention
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsRetention.
+func (in *ResultsRetention) DeepCopy() *ResultsRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3BackupTarget) DeepCopyInto(out *S3BackupTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BackupTarget.
+func (in *S3BackupTarget) DeepCopy() *S3BackupTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(S3BackupTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3LogsProperties) DeepCopyInto(out *S3LogsProperties) {
+	*out = *in
+	if in.ForcePathStyle != nil {
+		in, out := &in.ForcePathStyle, &out.ForcePathStyle
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MultiPartSize != nil {
+		in, out := &in.MultiPartSize, &out.MultiPartSize
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3LogsProperties.
+func (in *S3LogsProperties) DeepCopy() *S3LogsProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(S3LogsProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SCC) DeepCopyInto(out *SCC) {
 	*out = *in
@@ -1351,6 +2187,22 @@ func (in *Scope) DeepCopy() *Scope {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideApply) DeepCopyInto(out *ServerSideApply) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideApply.
+func (in *ServerSideApply) DeepCopy() *ServerSideApply {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideApply)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TektonAddon) DeepCopyInto(out *TektonAddon) {
 	*out = *in
@@ -1617,6 +2469,7 @@ func (in *TektonConfigList) DeepCopyObject() runtime.Object {
 func (in *TektonConfigSpec) DeepCopyInto(out *TektonConfigSpec) {
 	*out = *in
 	in.Config.DeepCopyInto(&out.Config)
+	in.ComponentConfig.DeepCopyInto(&out.ComponentConfig)
 	in.Pruner.DeepCopyInto(&out.Pruner)
 	in.TektonPruner.DeepCopyInto(&out.TektonPruner)
 	out.CommonSpec = in.CommonSpec
@@ -1638,6 +2491,21 @@ func (in *TektonConfigSpec) DeepCopyInto(out *TektonConfigSpec) {
 		*out = new(NamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UnmanagedResources != nil {
+		in, out := &in.UnmanagedResources, &out.UnmanagedResources
+		*out = make([]ResourceSelector, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(ServerSideApply)
+		**out = **in
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1662,6 +2530,18 @@ func (in *TektonConfigStatus) DeepCopyInto(out *TektonConfigStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.PlatformReport != nil {
+		in, out := &in.PlatformReport, &out.PlatformReport
+		*out = new(PlatformReportStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1856,9 +2736,14 @@ func (in *TektonHubSpec) DeepCopyInto(out *TektonHubSpec) {
 		}
 	}
 	in.Default.DeepCopyInto(&out.Default)
-	out.Db = in.Db
+	in.Db.DeepCopyInto(&out.Db)
 	out.Api = in.Api
 	out.CustomLogo = in.CustomLogo
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(HubIngressSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1888,6 +2773,11 @@ func (in *TektonHubStatus) DeepCopyInto(out *TektonHubStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.DBBackup != nil {
+		in, out := &in.DBBackup, &out.DBBackup
+		*out = new(DBBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1972,6 +2862,11 @@ func (in *TektonInstallerSetSpec) DeepCopyInto(out *TektonInstallerSetSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CompressedManifests != nil {
+		in, out := &in.CompressedManifests, &out.CompressedManifests
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1989,6 +2884,25 @@ func (in *TektonInstallerSetSpec) DeepCopy() *TektonInstallerSetSpec {
 func (in *TektonInstallerSetStatus) DeepCopyInto(out *TektonInstallerSetStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.UnmanagedResources != nil {
+		in, out := &in.UnmanagedResources, &out.UnmanagedResources
+		*out = make([]UnmanagedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConflictedResources != nil {
+		in, out := &in.ConflictedResources, &out.ConflictedResources
+		*out = make([]ConflictedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2106,6 +3020,92 @@ func (in *TektonPipelineStatus) DeepCopy() *TektonPipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonProfile) DeepCopyInto(out *TektonProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonProfile.
+func (in *TektonProfile) DeepCopy() *TektonProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonProfileList) DeepCopyInto(out *TektonProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TektonProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonProfileList.
+func (in *TektonProfileList) DeepCopy() *TektonProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TektonProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonProfileSpec) DeepCopyInto(out *TektonProfileSpec) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonProfileSpec.
+func (in *TektonProfileSpec) DeepCopy() *TektonProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TektonPruner) DeepCopyInto(out *TektonPruner) {
 	*out = *in
@@ -2297,6 +3297,11 @@ func (in *TektonResultSpec) DeepCopy() *TektonResultSpec {
 func (in *TektonResultStatus) DeepCopyInto(out *TektonResultStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.DBBackup != nil {
+		in, out := &in.DBBackup, &out.DBBackup
+		*out = new(DBBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2433,6 +3438,11 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 	*out = *in
 	out.TriggersProperties = in.TriggersProperties
 	in.Options.DeepCopyInto(&out.Options)
+	if in.EventListenerTLS != nil {
+		in, out := &in.EventListenerTLS, &out.EventListenerTLS
+		*out = new(EventListenerTLS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2463,6 +3473,38 @@ func (in *TriggersProperties) DeepCopy() *TriggersProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *URLCatalogSource) DeepCopyInto(out *URLCatalogSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new URLCatalogSource.
+func (in *URLCatalogSource) DeepCopy() *URLCatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(URLCatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnmanagedResource) DeepCopyInto(out *UnmanagedResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnmanagedResource.
+func (in *UnmanagedResource) DeepCopy() *UnmanagedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(UnmanagedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfigurationOptions) DeepCopyInto(out *WebhookConfigurationOptions) {
 	*out = *in
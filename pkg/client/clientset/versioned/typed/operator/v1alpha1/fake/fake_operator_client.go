@@ -64,6 +64,10 @@ func (c *FakeOperatorV1alpha1) TektonPipelines() v1alpha1.TektonPipelineInterfac
 	return newFakeTektonPipelines(c)
 }
 
+func (c *FakeOperatorV1alpha1) TektonProfiles() v1alpha1.TektonProfileInterface {
+	return newFakeTektonProfiles(c)
+}
+
 func (c *FakeOperatorV1alpha1) TektonPruners() v1alpha1.TektonPrunerInterface {
 	return newFakeTektonPruners(c)
 }
@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorv1alpha1 "github.com/tektoncd/operator/pkg/client/clientset/versioned/typed/operator/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeTektonProfiles implements TektonProfileInterface
+type fakeTektonProfiles struct {
+	*gentype.FakeClientWithList[*v1alpha1.TektonProfile, *v1alpha1.TektonProfileList]
+	Fake *FakeOperatorV1alpha1
+}
+
+func newFakeTektonProfiles(fake *FakeOperatorV1alpha1) operatorv1alpha1.TektonProfileInterface {
+	return &fakeTektonProfiles{
+		gentype.NewFakeClientWithList[*v1alpha1.TektonProfile, *v1alpha1.TektonProfileList](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("tektonprofiles"),
+			v1alpha1.SchemeGroupVersion.WithKind("TektonProfile"),
+			func() *v1alpha1.TektonProfile { return &v1alpha1.TektonProfile{} },
+			func() *v1alpha1.TektonProfileList { return &v1alpha1.TektonProfileList{} },
+			func(dst, src *v1alpha1.TektonProfileList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.TektonProfileList) []*v1alpha1.TektonProfile {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.TektonProfileList, items []*v1alpha1.TektonProfile) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
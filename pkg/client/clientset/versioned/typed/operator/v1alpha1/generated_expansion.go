@@ -36,6 +36,8 @@ type TektonInstallerSetExpansion interface{}
 
 type TektonPipelineExpansion interface{}
 
+type TektonProfileExpansion interface{}
+
 type TektonPrunerExpansion interface{}
 
 type TektonResultExpansion interface{}
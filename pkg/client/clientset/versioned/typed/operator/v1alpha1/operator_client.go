@@ -37,6 +37,7 @@ type OperatorV1alpha1Interface interface {
 	TektonHubsGetter
 	TektonInstallerSetsGetter
 	TektonPipelinesGetter
+	TektonProfilesGetter
 	TektonPrunersGetter
 	TektonResultsGetter
 	TektonTriggersGetter
@@ -83,6 +84,10 @@ func (c *OperatorV1alpha1Client) TektonPipelines() TektonPipelineInterface {
 	return newTektonPipelines(c)
 }
 
+func (c *OperatorV1alpha1Client) TektonProfiles() TektonProfileInterface {
+	return newTektonProfiles(c)
+}
+
 func (c *OperatorV1alpha1Client) TektonPruners() TektonPrunerInterface {
 	return newTektonPruners(c)
 }
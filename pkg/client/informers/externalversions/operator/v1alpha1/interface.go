@@ -42,6 +42,8 @@ type Interface interface {
 	TektonInstallerSets() TektonInstallerSetInformer
 	// TektonPipelines returns a TektonPipelineInformer.
 	TektonPipelines() TektonPipelineInformer
+	// TektonProfiles returns a TektonProfileInformer.
+	TektonProfiles() TektonProfileInformer
 	// TektonPruners returns a TektonPrunerInformer.
 	TektonPruners() TektonPrunerInformer
 	// TektonResults returns a TektonResultInformer.
@@ -106,6 +108,11 @@ func (v *version) TektonPipelines() TektonPipelineInformer {
 	return &tektonPipelineInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// TektonProfiles returns a TektonProfileInformer.
+func (v *version) TektonProfiles() TektonProfileInformer {
+	return &tektonProfileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // TektonPruners returns a TektonPrunerInformer.
 func (v *version) TektonPruners() TektonPrunerInformer {
 	return &tektonPrunerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -54,6 +54,10 @@ type TektonInstallerSetListerExpansion interface{}
 // TektonPipelineLister.
 type TektonPipelineListerExpansion interface{}
 
+// TektonProfileListerExpansion allows custom methods to be added to
+// TektonProfileLister.
+type TektonProfileListerExpansion interface{}
+
 // TektonPrunerListerExpansion allows custom methods to be added to
 // TektonPrunerLister.
 type TektonPrunerListerExpansion interface{}
@@ -0,0 +1,545 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	// postgres client (pg_dump, pg_restore) and aws cli container images via environment keys
+	dbBackupImageEnvKey   = "IMAGE_JOB_DB_BACKUP"
+	dbBackupS3ImageEnvKey = "IMAGE_JOB_DB_BACKUP_S3"
+
+	// labels used in resources managed by database backups, the value is the name of the database
+	dbBackupLabel  = "operator.tekton.dev/db-backup"
+	dbRestoreLabel = "operator.tekton.dev/db-restore"
+	// annotation of the restore job, holds the name of the restored backup
+	dbRestoreBackupNameAnnotation = "operator.tekton.dev/db-backup-name"
+	// annotation of the deployments scaled down during a restore, holds their replicas
+	dbRestoreReplicasAnnotation = "operator.tekton.dev/db-restore-replicas"
+
+	dbBackupDir             = "/backup"
+	dbBackupVolumeName      = "backup"
+	dbBackupDefaultS3Region = "us-east-1"
+
+	// scripts to be executed inside containers, a backup is named after the job which created it
+	dbDumpScript = `set -e
+echo "dumping database ${PGDATABASE} to ${BACKUP_NAME}.dump"
+pg_dump --format=custom --file="${BACKUP_DIR}/${BACKUP_NAME}.dump.tmp"
+mv "${BACKUP_DIR}/${BACKUP_NAME}.dump.tmp" "${BACKUP_DIR}/${BACKUP_NAME}.dump"
+`
+	dbPVCRetentionScript = `ls -1 "${BACKUP_DIR}" | grep '\.dump$' | sort | head -n -"${RETENTION}" | while read -r name; do
+  echo "deleting backup ${name}"
+  rm -f "${BACKUP_DIR}/${name}"
+done
+`
+	dbS3UploadScript = `set -e
+aws --endpoint-url "${S3_ENDPOINT}" s3 cp "${BACKUP_DIR}/${BACKUP_NAME}.dump" "s3://${S3_BUCKET}/${S3_PREFIX}${BACKUP_NAME}.dump"
+aws --endpoint-url "${S3_ENDPOINT}" s3api list-objects-v2 --bucket "${S3_BUCKET}" --prefix "${S3_PREFIX}" --query 'Contents[].Key' --output text \
+  | tr '\t' '\n' | grep '\.dump$' | sort | head -n -"${RETENTION}" | while read -r key; do
+  echo "deleting backup ${key}"
+  aws --endpoint-url "${S3_ENDPOINT}" s3 rm "s3://${S3_BUCKET}/${key}"
+done
+`
+	dbS3DownloadScript = `set -e
+aws --endpoint-url "${S3_ENDPOINT}" s3 cp "s3://${S3_BUCKET}/${S3_PREFIX}${BACKUP_NAME}.dump" "${BACKUP_DIR}/${BACKUP_NAME}.dump"
+`
+	dbRestoreScript = `set -e
+echo "restoring ${BACKUP_NAME}.dump to database ${PGDATABASE}"
+pg_restore --clean --if-exists --no-owner --single-transaction --dbname="${PGDATABASE}" "${BACKUP_DIR}/${BACKUP_NAME}.dump"
+`
+)
+
+// DBBackupStatusRequeue is returned by the reconcilers scheduling backups, the jobs of the
+// backup CronJob are not watched, they are listed on the next reconcile to update the status
+var DBBackupStatusRequeue = controller.NewRequeueAfter(5 * time.Minute)
+
+// DBBackupDatabase describes an operator managed PostgreSQL database
+type DBBackupDatabase struct {
+	// Name of the database, used as prefix of the backup CronJob and the restore Job
+	Name      string
+	Namespace string
+	OwnerRef  metav1.OwnerReference
+	// ConnectionEnv holds the PGHOST, PGPORT, PGDATABASE, PGUSER and PGPASSWORD variables
+	// used by pg_dump and pg_restore to connect to the database
+	ConnectionEnv []corev1.EnvVar
+	// Config of the component owning the database, applied to the backup and restore pods
+	Config v1alpha1.Config
+	// Deployments writing to the database, scaled down while a backup is restored
+	Deployments []string
+}
+
+func (db DBBackupDatabase) backupCronJobName() string {
+	return db.Name + "-backup"
+}
+
+func (db DBBackupDatabase) restoreJobName() string {
+	return db.Name + "-restore"
+}
+
+// ReconcileDBBackup creates or updates the CronJob backing up the database on the schedule of backup,
+// and deletes it if backup is nil. Returns status updated with the last successful backup job
+func ReconcileDBBackup(ctx context.Context, k kubernetes.Interface, db DBBackupDatabase, backup *v1alpha1.DBBackup, status *v1alpha1.DBBackupStatus) (*v1alpha1.DBBackupStatus, error) {
+	logger := logging.FromContext(ctx)
+	cronJobs := k.BatchV1().CronJobs(db.Namespace)
+
+	existing, err := cronJobs.Get(ctx, db.backupCronJobName(), metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return status, err
+	}
+	found := err == nil
+
+	if backup == nil {
+		if found {
+			logger.Infow("deleting the database backup cron job", "name", existing.GetName(), "namespace", db.Namespace)
+			if err := cronJobs.Delete(ctx, existing.GetName(), metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return status, err
+			}
+		}
+		return status, nil
+	}
+
+	desired, err := dbBackupCronJob(db, backup)
+	if err != nil {
+		return status, err
+	}
+
+	if !found {
+		logger.Infow("creating the database backup cron job", "name", desired.GetName(), "namespace", db.Namespace)
+		if _, err := cronJobs.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return status, err
+		}
+	} else if existing.GetAnnotations()[v1alpha1.LastAppliedHashKey] != desired.GetAnnotations()[v1alpha1.LastAppliedHashKey] {
+		logger.Infow("updating the database backup cron job", "name", existing.GetName(), "namespace", db.Namespace)
+		existing.SetLabels(desired.GetLabels())
+		existing.SetAnnotations(desired.GetAnnotations())
+		existing.Spec = desired.Spec
+		if _, err := cronJobs.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			return status, err
+		}
+	}
+
+	return lastSuccessfulDBBackup(ctx, k, db, status)
+}
+
+// lastSuccessfulDBBackup updates status with the most recent successful backup job, the status is
+// kept as it is when the jobs were already removed by the history limit of the CronJob
+func lastSuccessfulDBBackup(ctx context.Context, k kubernetes.Interface, db DBBackupDatabase, status *v1alpha1.DBBackupStatus) (*v1alpha1.DBBackupStatus, error) {
+	jobs, err := k.BatchV1().Jobs(db.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", dbBackupLabel, db.Name),
+	})
+	if err != nil {
+		return status, err
+	}
+
+	var latest *batchv1.Job
+	for index := range jobs.Items {
+		job := &jobs.Items[index]
+		if job.Status.Succeeded == 0 || job.Status.CompletionTime == nil {
+			continue
+		}
+		if latest == nil || job.Status.CompletionTime.After(latest.Status.CompletionTime.Time) {
+			latest = job
+		}
+	}
+	if latest == nil {
+		return status, nil
+	}
+	if status != nil && status.LastSuccessfulBackupTime != nil && !latest.Status.CompletionTime.After(status.LastSuccessfulBackupTime.Time) {
+		return status, nil
+	}
+
+	updated := &v1alpha1.DBBackupStatus{}
+	if status != nil {
+		updated = status.DeepCopy()
+	}
+	updated.LastSuccessfulBackup = latest.GetName()
+	updated.LastSuccessfulBackupTime = latest.Status.CompletionTime.DeepCopy()
+	return updated, nil
+}
+
+// RequestedDBRestore returns the name of the backup requested with the operator.tekton.dev/restore-db-backup
+// annotation of obj, "latest" is resolved to the last successful backup of status
+func RequestedDBRestore(obj metav1.Object, status *v1alpha1.DBBackupStatus) (string, error) {
+	backupName := strings.TrimSpace(obj.GetAnnotations()[v1alpha1.RestoreDBBackupKey])
+	if backupName == "" {
+		return "", fmt.Errorf("%s annotation requires the name of a backup, or %q", v1alpha1.RestoreDBBackupKey, v1alpha1.DBBackupLatest)
+	}
+	if backupName != v1alpha1.DBBackupLatest {
+		return backupName, nil
+	}
+	if status == nil || status.LastSuccessfulBackup == "" {
+		return "", fmt.Errorf("there is no successful backup to restore")
+	}
+	return status.LastSuccessfulBackup, nil
+}
+
+// RestoreDBBackup runs a Job restoring the backup named backupName from the target of backup. The deployments
+// writing to the database are scaled down until the Job finished, so that no write is lost during the restore.
+// The returned bool is true once the Job finished, the error is set if the restore failed
+func RestoreDBBackup(ctx context.Context, k kubernetes.Interface, db DBBackupDatabase, backup *v1alpha1.DBBackup, backupName string) (bool, error) {
+	logger := logging.FromContext(ctx)
+	if backup == nil {
+		return true, fmt.Errorf("backup of the database %s is not configured, there is no target to restore %s from", db.Name, backupName)
+	}
+	jobs := k.BatchV1().Jobs(db.Namespace)
+
+	existing, err := jobs.Get(ctx, db.restoreJobName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		stopped, err := scaleDownDBDeployments(ctx, k, db)
+		if err != nil || !stopped {
+			return false, err
+		}
+		logger.Infow("creating the database restore job", "name", db.restoreJobName(), "namespace", db.Namespace, "backup", backupName)
+		job, err := dbRestoreJob(db, backup, backupName)
+		if err != nil {
+			return false, err
+		}
+		if _, err := jobs.Create(ctx, job, metav1.CreateOptions{}); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	finished, failed := jobFinished(existing)
+	// a previous restore job of another backup is replaced once it finished
+	if existing.GetAnnotations()[dbRestoreBackupNameAnnotation] != backupName {
+		if !finished {
+			return false, fmt.Errorf("restore of the backup %s is still running", existing.GetAnnotations()[dbRestoreBackupNameAnnotation])
+		}
+		logger.Infow("deleting the previous database restore job", "name", existing.GetName(), "namespace", db.Namespace)
+		propagationPolicy := metav1.DeletePropagationBackground
+		if err := jobs.Delete(ctx, existing.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil && !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		return false, nil
+	}
+
+	if !finished {
+		return false, nil
+	}
+	if err := scaleUpDBDeployments(ctx, k, db); err != nil {
+		return false, err
+	}
+	if failed {
+		return true, fmt.Errorf("restore of the backup %s failed, see the logs of the job %s/%s", backupName, db.Namespace, existing.GetName())
+	}
+	return true, nil
+}
+
+// scaleDownDBDeployments scales the deployments writing to the database to zero and keeps their replicas
+// in an annotation. The deployments are not managed by the operator meanwhile, so that their replicas are
+// not reverted. Returns true once their pods are gone
+func scaleDownDBDeployments(ctx context.Context, k kubernetes.Interface, db DBBackupDatabase) (bool, error) {
+	deployments := k.AppsV1().Deployments(db.Namespace)
+	stopped := true
+	for _, name := range db.Deployments {
+		deployment, err := deployments.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if _, found := deployment.Annotations[dbRestoreReplicasAnnotation]; !found {
+			replicas := int32(1)
+			if deployment.Spec.Replicas != nil {
+				replicas = *deployment.Spec.Replicas
+			}
+			logging.FromContext(ctx).Infow("scaling down the deployment during the database restore", "name", name, "namespace", db.Namespace)
+			if deployment.Annotations == nil {
+				deployment.Annotations = map[string]string{}
+			}
+			deployment.Annotations[dbRestoreReplicasAnnotation] = strconv.Itoa(int(replicas))
+			deployment.Annotations[v1alpha1.ManagedKey] = "false"
+			deployment.Spec.Replicas = ptr.Int32(0)
+			if deployment, err = deployments.Update(ctx, deployment, metav1.UpdateOptions{}); err != nil {
+				return false, err
+			}
+		}
+		if deployment.Status.Replicas > 0 {
+			stopped = false
+		}
+	}
+	return stopped, nil
+}
+
+// scaleUpDBDeployments restores the replicas of the deployments scaled down by scaleDownDBDeployments,
+// and hands them back to the operator
+func scaleUpDBDeployments(ctx context.Context, k kubernetes.Interface, db DBBackupDatabase) error {
+	deployments := k.AppsV1().Deployments(db.Namespace)
+	for _, name := range db.Deployments {
+		deployment, err := deployments.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		value, found := deployment.Annotations[dbRestoreReplicasAnnotation]
+		if !found {
+			continue
+		}
+		replicas, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid annotation %s on the deployment %s/%s: %w", dbRestoreReplicasAnnotation, db.Namespace, name, err)
+		}
+		logging.FromContext(ctx).Infow("scaling up the deployment after the database restore", "name", name, "namespace", db.Namespace)
+		delete(deployment.Annotations, dbRestoreReplicasAnnotation)
+		delete(deployment.Annotations, v1alpha1.ManagedKey)
+		deployment.Spec.Replicas = ptr.Int32(int32(replicas))
+		if _, err := deployments.Update(ctx, deployment, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jobFinished returns whether the job completed, and if so whether it failed
+func jobFinished(job *batchv1.Job) (bool, bool) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, false
+		case batchv1.JobFailed:
+			return true, true
+		}
+	}
+	return false, false
+}
+
+func dbBackupCronJob(db DBBackupDatabase, backup *v1alpha1.DBBackup) (*batchv1.CronJob, error) {
+	backOffLimit := int32(1)
+	failedJobsHistoryLimit := int32(1)
+	successfulJobsHistoryLimit := int32(3)
+
+	// the job name, set as label by the job controller, is used as backup name
+	backupName := corev1.EnvVar{
+		Name: "BACKUP_NAME",
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.labels['job-name']"},
+		},
+	}
+
+	spec := batchv1.CronJobSpec{
+		Schedule:                   backup.Schedule,
+		ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+		FailedJobsHistoryLimit:     &failedJobsHistoryLimit,
+		SuccessfulJobsHistoryLimit: &successfulJobsHistoryLimit,
+		JobTemplate: batchv1.JobTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{dbBackupLabel: db.Name},
+			},
+			Spec: batchv1.JobSpec{
+				BackoffLimit: &backOffLimit,
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{dbBackupLabel: db.Name},
+					},
+					Spec: dbBackupPodSpec(db, backup, backupName, false),
+				},
+			},
+		},
+	}
+	if err := ApplyJobConfig(&spec.JobTemplate.Spec.Template, db.Config); err != nil {
+		return nil, err
+	}
+
+	specHash, err := hash.Compute(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            db.backupCronJobName(),
+			Namespace:       db.Namespace,
+			OwnerReferences: []metav1.OwnerReference{db.OwnerRef},
+			Labels:          map[string]string{dbBackupLabel: db.Name},
+			Annotations:     map[string]string{v1alpha1.LastAppliedHashKey: specHash},
+		},
+		Spec: spec,
+	}, nil
+}
+
+func dbRestoreJob(db DBBackupDatabase, backup *v1alpha1.DBBackup, backupName string) (*batchv1.Job, error) {
+	backOffLimit := int32(0)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            db.restoreJobName(),
+			Namespace:       db.Namespace,
+			OwnerReferences: []metav1.OwnerReference{db.OwnerRef},
+			Labels:          map[string]string{dbRestoreLabel: db.Name},
+			Annotations:     map[string]string{dbRestoreBackupNameAnnotation: backupName},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backOffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{dbRestoreLabel: db.Name},
+				},
+				Spec: dbBackupPodSpec(db, backup, corev1.EnvVar{Name: "BACKUP_NAME", Value: backupName}, true),
+			},
+		},
+	}
+	if err := ApplyJobConfig(&job.Spec.Template, db.Config); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// dbBackupPodSpec returns the pod running a backup, or a restore, of the database.
+// With a PVC target the backups are written to and read from the claim directly,
+// with an S3 target they are staged on an emptyDir volume and uploaded, or downloaded, by the aws cli
+func dbBackupPodSpec(db DBBackupDatabase, backup *v1alpha1.DBBackup, backupName corev1.EnvVar, restore bool) corev1.PodSpec {
+	retention := v1alpha1.DBBackupDefaultRetention
+	if backup.Retention != nil {
+		retention = *backup.Retention
+	}
+	env := []corev1.EnvVar{
+		{Name: "BACKUP_DIR", Value: dbBackupDir},
+		backupName,
+		{Name: "RETENTION", Value: strconv.FormatUint(uint64(retention), 10)},
+	}
+	volumeMounts := []corev1.VolumeMount{{Name: dbBackupVolumeName, MountPath: dbBackupDir}}
+
+	postgresContainer := func(name, script string) corev1.Container {
+		return dbBackupContainer(name, dbBackupImage(dbBackupImageEnvKey), script, append(append([]corev1.EnvVar{}, env...), db.ConnectionEnv...), nil, volumeMounts)
+	}
+
+	podSpec := corev1.PodSpec{
+		RestartPolicy:   corev1.RestartPolicyNever,
+		SecurityContext: dbBackupPodSecurityContext(),
+	}
+
+	if backup.PVC != nil {
+		podSpec.Volumes = []corev1.Volume{{
+			Name: dbBackupVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: backup.PVC.ClaimName},
+			},
+		}}
+		if restore {
+			podSpec.Containers = []corev1.Container{postgresContainer("pg-restore", dbRestoreScript)}
+		} else {
+			podSpec.Containers = []corev1.Container{postgresContainer("pg-dump", dbDumpScript+dbPVCRetentionScript)}
+		}
+		return podSpec
+	}
+
+	region := backup.S3.Region
+	if region == "" {
+		region = dbBackupDefaultS3Region
+	}
+	prefix := strings.Trim(backup.S3.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	s3Env := append(append([]corev1.EnvVar{}, env...),
+		corev1.EnvVar{Name: "S3_ENDPOINT", Value: backup.S3.Endpoint},
+		corev1.EnvVar{Name: "S3_BUCKET", Value: backup.S3.Bucket},
+		corev1.EnvVar{Name: "S3_PREFIX", Value: prefix},
+		corev1.EnvVar{Name: "AWS_DEFAULT_REGION", Value: region},
+		corev1.EnvVar{Name: "HOME", Value: "/tmp"},
+	)
+	s3EnvFrom := []corev1.EnvFromSource{{
+		SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: backup.S3.SecretName}},
+	}}
+	s3Container := func(name, script string) corev1.Container {
+		return dbBackupContainer(name, dbBackupImage(dbBackupS3ImageEnvKey), script, s3Env, s3EnvFrom, volumeMounts)
+	}
+
+	podSpec.Volumes = []corev1.Volume{{
+		Name:         dbBackupVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}}
+	if restore {
+		podSpec.InitContainers = []corev1.Container{s3Container("s3-download", dbS3DownloadScript)}
+		podSpec.Containers = []corev1.Container{postgresContainer("pg-restore", dbRestoreScript)}
+	} else {
+		podSpec.InitContainers = []corev1.Container{postgresContainer("pg-dump", dbDumpScript)}
+		podSpec.Containers = []corev1.Container{s3Container("s3-upload", dbS3UploadScript)}
+	}
+	return podSpec
+}
+
+// dbBackupImage returns the image set in the environment key, with the registry override applied
+func dbBackupImage(envKey string) string {
+	return ImageRegistryDomainOverride(map[string]string{envKey: os.Getenv(envKey)})[envKey]
+}
+
+func dbBackupContainer(name, image, script string, env []corev1.EnvVar, envFrom []corev1.EnvFromSource, volumeMounts []corev1.VolumeMount) corev1.Container {
+	allowPrivilegedEscalation := false
+	return corev1.Container{
+		Name:                     name,
+		Image:                    image,
+		Command:                  []string{"/bin/sh", "-c", script},
+		Env:                      env,
+		EnvFrom:                  envFrom,
+		VolumeMounts:             volumeMounts,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: &allowPrivilegedEscalation,
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+	}
+}
+
+func dbBackupPodSecurityContext() *corev1.PodSecurityContext {
+	runAsNonRoot := true
+	runAsUser := ptr.Int64(65532)
+	fsGroup := ptr.Int64(65532)
+	// if it is a openshift platform remove the user and fsGroup ids
+	// those ids will be allocated dynamically
+	if v1alpha1.IsOpenShiftPlatform() {
+		runAsUser = nil
+		fsGroup = nil
+	}
+	return &corev1.PodSecurityContext{
+		RunAsNonRoot: &runAsNonRoot,
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+		RunAsUser: runAsUser,
+		FSGroup:   fsGroup,
+	}
+}
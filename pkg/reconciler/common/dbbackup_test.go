@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+)
+
+var testDBBackupDatabase = DBBackupDatabase{
+	Name:      "tekton-results-postgres",
+	Namespace: "tekton-pipelines",
+	ConnectionEnv: []corev1.EnvVar{
+		{Name: "PGHOST", Value: "tekton-results-postgres-service"},
+		{Name: "PGDATABASE", Value: "tekton-results"},
+	},
+}
+
+func TestReconcileDBBackup(t *testing.T) {
+	t.Setenv(dbBackupImageEnvKey, "postgres:15")
+	t.Setenv(dbBackupS3ImageEnvKey, "amazon/aws-cli")
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	retention := uint(3)
+
+	// a PVC target runs pg_dump on the claim
+	backup := &v1alpha1.DBBackup{Schedule: "0 2 * * *", Retention: &retention, PVC: &v1alpha1.PVCBackupTarget{ClaimName: "backups"}}
+	status, err := ReconcileDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, nil)
+	assert.NilError(t, err)
+	assert.Assert(t, status == nil)
+
+	cronJob, err := kubeClient.BatchV1().CronJobs("tekton-pipelines").Get(ctx, "tekton-results-postgres-backup", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cronJob.Spec.Schedule, "0 2 * * *")
+	podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	assert.Equal(t, len(podSpec.InitContainers), 0)
+	assert.Equal(t, len(podSpec.Containers), 1)
+	assert.Equal(t, podSpec.Containers[0].Image, "postgres:15")
+	assert.Equal(t, podSpec.Containers[0].Command[2], dbDumpScript+dbPVCRetentionScript)
+	assert.Equal(t, podSpec.Volumes[0].PersistentVolumeClaim.ClaimName, "backups")
+	assert.DeepEqual(t, podSpec.Containers[0].Env[2], corev1.EnvVar{Name: "RETENTION", Value: "3"})
+	assert.DeepEqual(t, podSpec.Containers[0].Env[3:], testDBBackupDatabase.ConnectionEnv)
+	pvcHash := cronJob.GetAnnotations()[v1alpha1.LastAppliedHashKey]
+
+	// an S3 target dumps on an emptyDir and uploads the backup with the aws cli
+	backup = &v1alpha1.DBBackup{Schedule: "@daily", S3: &v1alpha1.S3BackupTarget{
+		Endpoint: "http://minio.minio.svc:9000", Bucket: "backups", Prefix: "/results", SecretName: "s3-credentials",
+	}}
+	_, err = ReconcileDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, nil)
+	assert.NilError(t, err)
+
+	cronJob, err = kubeClient.BatchV1().CronJobs("tekton-pipelines").Get(ctx, "tekton-results-postgres-backup", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, cronJob.GetAnnotations()[v1alpha1.LastAppliedHashKey] != pvcHash)
+	assert.Equal(t, cronJob.Spec.Schedule, "@daily")
+	podSpec = cronJob.Spec.JobTemplate.Spec.Template.Spec
+	assert.Equal(t, podSpec.InitContainers[0].Name, "pg-dump")
+	assert.Equal(t, podSpec.Containers[0].Name, "s3-upload")
+	assert.Equal(t, podSpec.Containers[0].Image, "amazon/aws-cli")
+	assert.Assert(t, podSpec.Volumes[0].EmptyDir != nil)
+	assert.Equal(t, podSpec.Containers[0].EnvFrom[0].SecretRef.Name, "s3-credentials")
+	assert.DeepEqual(t, podSpec.Containers[0].Env[2:7], []corev1.EnvVar{
+		{Name: "RETENTION", Value: "7"},
+		{Name: "S3_ENDPOINT", Value: "http://minio.minio.svc:9000"},
+		{Name: "S3_BUCKET", Value: "backups"},
+		{Name: "S3_PREFIX", Value: "results/"},
+		{Name: "AWS_DEFAULT_REGION", Value: "us-east-1"},
+	})
+
+	// the cron job is removed with the backup
+	_, err = ReconcileDBBackup(ctx, kubeClient, testDBBackupDatabase, nil, nil)
+	assert.NilError(t, err)
+	_, err = kubeClient.BatchV1().CronJobs("tekton-pipelines").Get(ctx, "tekton-results-postgres-backup", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
+
+func TestReconcileDBBackup_Config(t *testing.T) {
+	t.Setenv(dbBackupImageEnvKey, "postgres:15")
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+
+	db := testDBBackupDatabase
+	db.Config = v1alpha1.Config{
+		NodeSelector:      map[string]string{"node": "infra"},
+		PriorityClassName: "low",
+		PodLabels:         map[string]string{"team": "ci"},
+	}
+	backup := &v1alpha1.DBBackup{Schedule: "0 2 * * *", PVC: &v1alpha1.PVCBackupTarget{ClaimName: "backups"}}
+	_, err := ReconcileDBBackup(ctx, kubeClient, db, backup, nil)
+	assert.NilError(t, err)
+
+	cronJob, err := kubeClient.BatchV1().CronJobs("tekton-pipelines").Get(ctx, "tekton-results-postgres-backup", metav1.GetOptions{})
+	assert.NilError(t, err)
+	pod := cronJob.Spec.JobTemplate.Spec.Template
+	assert.DeepEqual(t, pod.Spec.NodeSelector, db.Config.NodeSelector)
+	assert.Equal(t, pod.Spec.PriorityClassName, "low")
+	assert.Equal(t, pod.Labels["team"], "ci")
+
+	restore, err := dbRestoreJob(db, backup, "tekton-results-postgres-backup-100")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, restore.Spec.Template.Spec.NodeSelector, db.Config.NodeSelector)
+	assert.Equal(t, restore.Spec.Template.Labels[dbRestoreLabel], "tekton-results-postgres")
+}
+
+func TestReconcileDBBackup_Status(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Now()
+	backupJob := func(name string, succeeded int32, completion time.Time) *batchv1.Job {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "tekton-pipelines",
+				Labels:    map[string]string{dbBackupLabel: "tekton-results-postgres"},
+			},
+			Status: batchv1.JobStatus{Succeeded: succeeded},
+		}
+		if succeeded > 0 {
+			job.Status.CompletionTime = &metav1.Time{Time: completion}
+		}
+		return job
+	}
+	kubeClient := fake.NewSimpleClientset(
+		backupJob("tekton-results-postgres-backup-100", 1, now.Add(-2*time.Hour)),
+		backupJob("tekton-results-postgres-backup-160", 1, now.Add(-time.Hour)),
+		backupJob("tekton-results-postgres-backup-220", 0, now),
+	)
+	backup := &v1alpha1.DBBackup{Schedule: "0 * * * *", PVC: &v1alpha1.PVCBackupTarget{ClaimName: "backups"}}
+
+	previous := &v1alpha1.DBBackupStatus{
+		LastSuccessfulBackup:     "tekton-results-postgres-backup-40",
+		LastSuccessfulBackupTime: &metav1.Time{Time: now.Add(-3 * time.Hour)},
+		LastRestoredBackup:       "tekton-results-postgres-backup-10",
+	}
+	status, err := ReconcileDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, previous)
+	assert.NilError(t, err)
+	assert.Equal(t, status.LastSuccessfulBackup, "tekton-results-postgres-backup-160")
+	assert.Equal(t, status.LastRestoredBackup, "tekton-results-postgres-backup-10")
+	assert.Equal(t, previous.LastSuccessfulBackup, "tekton-results-postgres-backup-40")
+
+	// a more recent backup recorded in status is kept
+	previous.LastSuccessfulBackupTime = &metav1.Time{Time: now}
+	status, err = ReconcileDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, previous)
+	assert.NilError(t, err)
+	assert.Equal(t, status.LastSuccessfulBackup, "tekton-results-postgres-backup-40")
+}
+
+func TestRequestedDBRestore(t *testing.T) {
+	obj := &v1alpha1.TektonResult{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{v1alpha1.RestoreDBBackupKey: v1alpha1.DBBackupLatest},
+	}}
+
+	_, err := RequestedDBRestore(obj, nil)
+	assert.Error(t, err, "there is no successful backup to restore")
+
+	backupName, err := RequestedDBRestore(obj, &v1alpha1.DBBackupStatus{LastSuccessfulBackup: "tekton-results-postgres-backup-160"})
+	assert.NilError(t, err)
+	assert.Equal(t, backupName, "tekton-results-postgres-backup-160")
+
+	obj.Annotations[v1alpha1.RestoreDBBackupKey] = "tekton-results-postgres-backup-100"
+	backupName, err = RequestedDBRestore(obj, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, backupName, "tekton-results-postgres-backup-100")
+}
+
+func TestRestoreDBBackup(t *testing.T) {
+	ctx := context.TODO()
+	kubeClient := fake.NewSimpleClientset()
+	backup := &v1alpha1.DBBackup{Schedule: "@daily", S3: &v1alpha1.S3BackupTarget{
+		Endpoint: "http://minio.minio.svc:9000", Bucket: "backups", SecretName: "s3-credentials",
+	}}
+	jobs := kubeClient.BatchV1().Jobs("tekton-pipelines")
+	setCondition := func(conditionType batchv1.JobConditionType) {
+		job, err := jobs.Get(ctx, "tekton-results-postgres-restore", metav1.GetOptions{})
+		assert.NilError(t, err)
+		job.Status.Conditions = []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue}}
+		_, err = jobs.UpdateStatus(ctx, job, metav1.UpdateOptions{})
+		assert.NilError(t, err)
+	}
+
+	// the restore job is created and reported running
+	finished, err := RestoreDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, "tekton-results-postgres-backup-100")
+	assert.NilError(t, err)
+	assert.Assert(t, !finished)
+	job, err := jobs.Get(ctx, "tekton-results-postgres-restore", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, job.Spec.Template.Spec.InitContainers[0].Name, "s3-download")
+	assert.Equal(t, job.Spec.Template.Spec.Containers[0].Command[2], dbRestoreScript)
+	assert.DeepEqual(t, job.Spec.Template.Spec.Containers[0].Env[1], corev1.EnvVar{Name: "BACKUP_NAME", Value: "tekton-results-postgres-backup-100"})
+
+	finished, err = RestoreDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, "tekton-results-postgres-backup-100")
+	assert.NilError(t, err)
+	assert.Assert(t, !finished)
+
+	// another backup can not be restored while a restore is running
+	_, err = RestoreDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, "tekton-results-postgres-backup-160")
+	assert.Error(t, err, "restore of the backup tekton-results-postgres-backup-100 is still running")
+
+	setCondition(batchv1.JobFailed)
+	finished, err = RestoreDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, "tekton-results-postgres-backup-100")
+	assert.Assert(t, finished)
+	assert.Error(t, err, "restore of the backup tekton-results-postgres-backup-100 failed, see the logs of the job tekton-pipelines/tekton-results-postgres-restore")
+
+	// a finished restore job is replaced by the restore of another backup
+	finished, err = RestoreDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, "tekton-results-postgres-backup-160")
+	assert.NilError(t, err)
+	assert.Assert(t, !finished)
+	_, err = jobs.Get(ctx, "tekton-results-postgres-restore", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	_, err = RestoreDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, "tekton-results-postgres-backup-160")
+	assert.NilError(t, err)
+	setCondition(batchv1.JobComplete)
+	finished, err = RestoreDBBackup(ctx, kubeClient, testDBBackupDatabase, backup, "tekton-results-postgres-backup-160")
+	assert.NilError(t, err)
+	assert.Assert(t, finished)
+}
+
+func TestRestoreDBBackup_ScalesDownDeployments(t *testing.T) {
+	ctx := context.TODO()
+	api := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-results-api", Namespace: "tekton-pipelines"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.Int32(2)},
+		Status:     appsv1.DeploymentStatus{Replicas: 2},
+	}
+	kubeClient := fake.NewSimpleClientset(api)
+	db := testDBBackupDatabase
+	db.Deployments = []string{"tekton-results-api", "tekton-results-watcher"}
+	backup := &v1alpha1.DBBackup{Schedule: "@daily", PVC: &v1alpha1.PVCBackupTarget{ClaimName: "backups"}}
+	deployments := kubeClient.AppsV1().Deployments("tekton-pipelines")
+	jobs := kubeClient.BatchV1().Jobs("tekton-pipelines")
+
+	// the api is scaled down and left alone by the operator, the restore waits for its pods to stop
+	finished, err := RestoreDBBackup(ctx, kubeClient, db, backup, "tekton-results-postgres-backup-100")
+	assert.NilError(t, err)
+	assert.Assert(t, !finished)
+	deployment, err := deployments.Get(ctx, "tekton-results-api", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, *deployment.Spec.Replicas, int32(0))
+	assert.Equal(t, deployment.Annotations[v1alpha1.ManagedKey], "false")
+	_, err = jobs.Get(ctx, "tekton-results-postgres-restore", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	// the pods of the api are gone, the restore job is created
+	deployment.Status.Replicas = 0
+	_, err = deployments.UpdateStatus(ctx, deployment, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	_, err = RestoreDBBackup(ctx, kubeClient, db, backup, "tekton-results-postgres-backup-100")
+	assert.NilError(t, err)
+	job, err := jobs.Get(ctx, "tekton-results-postgres-restore", metav1.GetOptions{})
+	assert.NilError(t, err)
+
+	// the api is scaled up once the restore finished
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	_, err = jobs.UpdateStatus(ctx, job, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	finished, err = RestoreDBBackup(ctx, kubeClient, db, backup, "tekton-results-postgres-backup-100")
+	assert.NilError(t, err)
+	assert.Assert(t, finished)
+	deployment, err = deployments.Get(ctx, "tekton-results-api", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, *deployment.Spec.Replicas, int32(2))
+	_, found := deployment.Annotations[v1alpha1.ManagedKey]
+	assert.Assert(t, !found)
+	_, found = deployment.Annotations[dbRestoreReplicasAnnotation]
+	assert.Assert(t, !found)
+}
+
+func TestDBBackupImage_RegistryOverride(t *testing.T) {
+	t.Setenv(dbBackupS3ImageEnvKey, "docker.io/amazon/aws-cli@sha256:0a3d2b1f3c7e")
+	assert.Equal(t, dbBackupImage(dbBackupS3ImageEnvKey), "docker.io/amazon/aws-cli@sha256:0a3d2b1f3c7e")
+
+	t.Setenv(ImageRegistryOverride, "registry.example.com")
+	assert.Equal(t, dbBackupImage(dbBackupS3ImageEnvKey), "registry.example.com/amazon/aws-cli@sha256:0a3d2b1f3c7e")
+}
@@ -18,77 +18,189 @@ package common
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
 )
 
-var webhookNames = map[string]string{
-	v1alpha1.PipelineResourceName: "config.webhook.pipeline.tekton.dev",
-	v1alpha1.TriggerResourceName:  "config.webhook.triggers.tekton.dev",
+const (
+	// PreemptedFailurePoliciesKey holds the failurePolicy of each webhook before it was relaxed
+	// to break the deadlock, used to restore them once the webhook endpoints are ready
+	PreemptedFailurePoliciesKey = "operator.tekton.dev/preempted-failure-policies"
+
+	// configWebhookPrefix is the prefix of the knative config validation webhooks,
+	// which fill their rules by themselves when the webhook pod comes up
+	configWebhookPrefix = "config.webhook."
+
+	failurePolicyIgnore = "Ignore"
+)
+
+// PreemptDeadlock breaks the deadlock caused by the admission webhooks of a component when their
+// endpoints are gone, for example during a reinstall, the webhook pods can't come up as the
+// webhooks reject the resources required to bring them up.
+// The webhook and service pairs are derived from the Validating and Mutating webhook configurations
+// of the manifest. For a service without ready endpoints, the rules of the config webhooks are dropped
+// and the failurePolicy of the other webhooks is relaxed to Ignore. The relaxed failurePolicy is
+// restored once the endpoints are ready. An Event is recorded on the component every time the preemption fires.
+func PreemptDeadlock(ctx context.Context, m *manifestival.Manifest, kc kubernetes.Interface, comp v1alpha1.TektonComponent) error {
+	logger := logging.FromContext(ctx)
+	// services of the manifest are installed in the target namespace of the component
+	targetNamespace := comp.GetSpec().GetTargetNamespace()
+
+	webhookConfigs := m.Filter(manifestival.Any(
+		manifestival.ByKind("ValidatingWebhookConfiguration"),
+		manifestival.ByKind("MutatingWebhookConfiguration"),
+	))
+
+	// check if there are pod endpoints populated for each webhook service
+	endpointsActive := map[string]bool{}
+	for _, webhookConfig := range webhookConfigs.Resources() {
+		for _, svc := range webhookServices(&webhookConfig, targetNamespace) {
+			if _, found := endpointsActive[svc.key()]; found {
+				continue
+			}
+			// webhooks pointing to services which are not part of the manifest are not owned by the component
+			if len(m.Filter(manifestival.ByKind("Service"), manifestival.ByName(svc.name)).Resources()) == 0 {
+				continue
+			}
+			active, err := isWebhookEndpointsActive(ctx, kc, svc)
+			if err != nil {
+				return fmt.Errorf("failed to check webhook endpoints: %w", err)
+			}
+			endpointsActive[svc.key()] = active
+		}
+	}
+
+	for _, webhookConfig := range webhookConfigs.Resources() {
+		existing, err := m.Client.Get(&webhookConfig)
+		if err != nil {
+			// nothing can deadlock if the webhook configuration is not there yet
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get %s %s: %w", webhookConfig.GetKind(), webhookConfig.GetName(), err)
+		}
+
+		inactive := inactiveWebhooks(&webhookConfig, endpointsActive, targetNamespace)
+		if len(inactive) == 0 {
+			if err := restoreFailurePolicies(m.Client, existing); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// If endpoints are empty, set config webhook definition rules to the
+		// initial state where the webhook pod can refill the rules when it comes up
+		if existing.GetKind() == "ValidatingWebhookConfiguration" && strings.HasPrefix(existing.GetName(), configWebhookPrefix) {
+			if err := removeValidatingWebhookRules(m, existing.GetName()); err != nil {
+				return err
+			}
+			recordPreemption(ctx, comp, existing, "rules removed")
+			continue
+		}
+
+		relaxed, err := relaxFailurePolicies(m.Client, existing, inactive)
+		if err != nil {
+			return err
+		}
+		if relaxed {
+			logger.Infow("relaxed failurePolicy of webhooks without ready endpoints",
+				"kind", existing.GetKind(), "name", existing.GetName(), "webhooks", inactive)
+			recordPreemption(ctx, comp, existing, fmt.Sprintf("failurePolicy set to %s for %s", failurePolicyIgnore, strings.Join(inactive, ", ")))
+		}
+	}
+	return nil
 }
 
-var webhookServiceNames = map[string]string{
-	v1alpha1.PipelineResourceName: "tekton-pipelines-webhook",
-	v1alpha1.TriggerResourceName:  "tekton-triggers-webhook",
+type webhookService struct {
+	namespace string
+	name      string
 }
 
-func PreemptDeadlock(ctx context.Context, m *manifestival.Manifest, kc kubernetes.Interface, component string) error {
+func (s webhookService) key() string {
+	return fmt.Sprintf("%s/%s", s.namespace, s.name)
+}
 
-	// check if there are pod endpoints populated for webhhook service
-	webhookServiceName, ok := webhookServiceNames[component]
-	if !ok {
-		return fmt.Errorf("no webhook service name found for component %s", component)
+// webhooks returns the webhooks of a webhook configuration
+func webhooks(u *unstructured.Unstructured) []map[string]interface{} {
+	list, _, _ := unstructured.NestedSlice(u.Object, "webhooks")
+	webhooks := []map[string]interface{}{}
+	for _, item := range list {
+		if webhook, ok := item.(map[string]interface{}); ok {
+			webhooks = append(webhooks, webhook)
+		}
 	}
-	ok, err := isWebhookEndpointsActive(ctx, m, kc, webhookServiceName)
-	if err != nil {
-		return fmt.Errorf("failed to check webhook endpoints: %w", err)
+	return webhooks
+}
+
+// serviceOf returns the service a webhook calls, if it is configured with a service
+func serviceOf(webhook map[string]interface{}, targetNamespace string) (webhookService, bool) {
+	name, _, _ := unstructured.NestedString(webhook, "clientConfig", "service", "name")
+	namespace, _, _ := unstructured.NestedString(webhook, "clientConfig", "service", "namespace")
+	if targetNamespace != "" {
+		namespace = targetNamespace
 	}
-	// If endpoints are active, no deadlock prevention needed
-	if ok {
-		return nil
+	if name == "" {
+		return webhookService{}, false
 	}
+	return webhookService{namespace: namespace, name: name}, true
+}
 
-	// If endpoints are empty, set webhook definition rules
-	// to the initial state where the webhook pod can refill the rules when it comes up
-	webhookName, ok := webhookNames[component]
-	if !ok {
-		return fmt.Errorf("no webhook name found for component %s", component)
+// webhookServices returns the services the webhooks of a webhook configuration call
+func webhookServices(u *unstructured.Unstructured, targetNamespace string) []webhookService {
+	services := []webhookService{}
+	for _, webhook := range webhooks(u) {
+		if svc, ok := serviceOf(webhook, targetNamespace); ok {
+			services = append(services, svc)
+		}
 	}
+	return services
+}
 
-	err = removeValidatingWebhookRules(m, kc, webhookName)
-	if err != nil {
-		return err
+// inactiveWebhooks returns the name of the webhooks whose service endpoints are not ready
+func inactiveWebhooks(u *unstructured.Unstructured, endpointsActive map[string]bool, targetNamespace string) []string {
+	inactive := []string{}
+	for _, webhook := range webhooks(u) {
+		svc, ok := serviceOf(webhook, targetNamespace)
+		if !ok {
+			continue
+		}
+		if active, found := endpointsActive[svc.key()]; found && !active {
+			name, _, _ := unstructured.NestedString(webhook, "name")
+			inactive = append(inactive, name)
+		}
 	}
-	return nil
+	return inactive
 }
 
 // isWebhookEndpointsActive checks if the there are valid Endpoint resources associated with a webhook service
-func isWebhookEndpointsActive(ctx context.Context, m *manifestival.Manifest, kc kubernetes.Interface, svcName string) (bool, error) {
-	svcResource := m.Filter(manifestival.ByKind("Service"), manifestival.ByName(svcName))
-	if len(svcResource.Resources()) == 0 {
-		return false, fmt.Errorf("service %s not found in manifest", svcName)
-	}
-	targetNamespace := svcResource.Resources()[0].GetNamespace()
-	endPoint, err := kc.CoreV1().Endpoints(targetNamespace).Get(ctx, svcName, v1.GetOptions{})
+func isWebhookEndpointsActive(ctx context.Context, kc kubernetes.Interface, svc webhookService) (bool, error) {
+	endPoint, err := kc.CoreV1().Endpoints(svc.namespace).Get(ctx, svc.name, v1.GetOptions{})
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return false, nil
 		}
-		return false, fmt.Errorf("failed to get endpoint %s in namespace %s: %w", svcName, targetNamespace, err)
+		return false, fmt.Errorf("failed to get endpoint %s in namespace %s: %w", svc.name, svc.namespace, err)
 	}
 
 	return len(endPoint.Subsets) > 0, nil
 }
 
 // removeValidatingWebhookRules remove "rules" from config.webhook.** webhook definiton(s)
-func removeValidatingWebhookRules(m *manifestival.Manifest, kc kubernetes.Interface, webhookName string) error {
-	cmValidationWebHookManifest := m.Filter(manifestival.ByName(webhookName))
+func removeValidatingWebhookRules(m *manifestival.Manifest, webhookName string) error {
+	cmValidationWebHookManifest := m.Filter(manifestival.ByKind("ValidatingWebhookConfiguration"), manifestival.ByName(webhookName))
 	transformed, err := cmValidationWebHookManifest.Transform(removeWebhooks)
 	if err != nil {
 		return fmt.Errorf("failed to transform manifest for config webhook %s: %w", webhookName, err)
@@ -104,3 +216,143 @@ func removeWebhooks(u *unstructured.Unstructured) error {
 	unstructured.RemoveNestedField(u.Object, "webhooks")
 	return nil
 }
+
+// relaxFailurePolicies sets the failurePolicy of the given webhooks to Ignore on the existing
+// webhook configuration and keeps the previous failurePolicy in an annotation
+func relaxFailurePolicies(client manifestival.Client, existing *unstructured.Unstructured, webhookNames []string) (bool, error) {
+	preempted, err := preemptedFailurePolicies(existing)
+	if err != nil {
+		return false, err
+	}
+
+	updated := existing.DeepCopy()
+	list := []interface{}{}
+	relaxed := false
+	for _, webhook := range webhooks(updated) {
+		name, _, _ := unstructured.NestedString(webhook, "name")
+		policy, _, _ := unstructured.NestedString(webhook, "failurePolicy")
+		if slices.Contains(webhookNames, name) && policy != failurePolicyIgnore {
+			// an empty failurePolicy defaults to Fail
+			preempted[name] = policy
+			webhook["failurePolicy"] = failurePolicyIgnore
+			relaxed = true
+		}
+		list = append(list, webhook)
+	}
+	if !relaxed {
+		return false, nil
+	}
+
+	if err := unstructured.SetNestedSlice(updated.Object, list, "webhooks"); err != nil {
+		return false, err
+	}
+	if err := setPreemptedFailurePolicies(updated, preempted); err != nil {
+		return false, err
+	}
+	if err := client.Update(updated); err != nil {
+		return false, fmt.Errorf("failed to relax failurePolicy on %s %s: %w", existing.GetKind(), existing.GetName(), err)
+	}
+	return true, nil
+}
+
+// restoreFailurePolicies restores the failurePolicy relaxed by relaxFailurePolicies
+func restoreFailurePolicies(client manifestival.Client, existing *unstructured.Unstructured) error {
+	if _, found := existing.GetAnnotations()[PreemptedFailurePoliciesKey]; !found {
+		return nil
+	}
+	preempted, err := preemptedFailurePolicies(existing)
+	if err != nil {
+		return err
+	}
+
+	updated := existing.DeepCopy()
+	list := []interface{}{}
+	for _, webhook := range webhooks(updated) {
+		name, _, _ := unstructured.NestedString(webhook, "name")
+		if policy, found := preempted[name]; found {
+			if policy == "" {
+				delete(webhook, "failurePolicy")
+			} else {
+				webhook["failurePolicy"] = policy
+			}
+		}
+		list = append(list, webhook)
+	}
+	if err := unstructured.SetNestedSlice(updated.Object, list, "webhooks"); err != nil {
+		return err
+	}
+
+	annotations := updated.GetAnnotations()
+	delete(annotations, PreemptedFailurePoliciesKey)
+	updated.SetAnnotations(annotations)
+
+	if err := client.Update(updated); err != nil {
+		return fmt.Errorf("failed to restore failurePolicy on %s %s: %w", existing.GetKind(), existing.GetName(), err)
+	}
+	return nil
+}
+
+// KeepPreemptedFailurePolicies carries the failurePolicy relaxed by the deadlock preemption on the
+// existing webhook configuration over to the expected one, so that applying the manifest or reverting
+// a drift does not bring back the deadlock before the webhook endpoints are ready
+func KeepPreemptedFailurePolicies(expected, existing *unstructured.Unstructured) error {
+	if _, found := existing.GetAnnotations()[PreemptedFailurePoliciesKey]; !found {
+		return nil
+	}
+	preempted, err := preemptedFailurePolicies(existing)
+	if err != nil {
+		return err
+	}
+
+	list := []interface{}{}
+	for _, webhook := range webhooks(expected) {
+		name, _, _ := unstructured.NestedString(webhook, "name")
+		if _, found := preempted[name]; found {
+			webhook["failurePolicy"] = failurePolicyIgnore
+		}
+		list = append(list, webhook)
+	}
+	if len(list) > 0 {
+		if err := unstructured.SetNestedSlice(expected.Object, list, "webhooks"); err != nil {
+			return err
+		}
+	}
+	return setPreemptedFailurePolicies(expected, preempted)
+}
+
+func preemptedFailurePolicies(u *unstructured.Unstructured) (map[string]string, error) {
+	preempted := map[string]string{}
+	value, found := u.GetAnnotations()[PreemptedFailurePoliciesKey]
+	if !found {
+		return preempted, nil
+	}
+	if err := json.Unmarshal([]byte(value), &preempted); err != nil {
+		return nil, fmt.Errorf("failed to read annotation %s on %s %s: %w", PreemptedFailurePoliciesKey, u.GetKind(), u.GetName(), err)
+	}
+	return preempted, nil
+}
+
+func setPreemptedFailurePolicies(u *unstructured.Unstructured, preempted map[string]string) error {
+	value, err := json.Marshal(preempted)
+	if err != nil {
+		return err
+	}
+	annotations := u.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[PreemptedFailurePoliciesKey] = string(value)
+	u.SetAnnotations(annotations)
+	return nil
+}
+
+// recordPreemption emits an event on the component about the preempted webhook configuration
+func recordPreemption(ctx context.Context, comp v1alpha1.TektonComponent, webhookConfig *unstructured.Unstructured, action string) {
+	recorder := controller.GetEventRecorder(ctx)
+	obj, ok := comp.(runtime.Object)
+	if recorder == nil || !ok {
+		return
+	}
+	recorder.Eventf(obj, corev1.EventTypeWarning, "WebhookDeadlockPreempted",
+		"webhook endpoints are not ready, %s on %s %s", action, webhookConfig.GetKind(), webhookConfig.GetName())
+}
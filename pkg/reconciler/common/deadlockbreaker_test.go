@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"testing"
 
+	"gotest.tools/v3/assert"
+
 	mf "github.com/manifestival/manifestival"
 	mff "github.com/manifestival/manifestival/fake"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
@@ -36,15 +38,15 @@ func TestPreemptDeadlock(t *testing.T) {
 	tests := []struct {
 		name        string
 		resources   []unstructured.Unstructured
-		component   string
+		component   v1alpha1.TektonComponent
 		expectError bool
 		endpoints   *v1.Endpoints
 	}{
 		{
-			name:        "Webhook service not found",
+			name:        "No webhook configurations",
 			resources:   []unstructured.Unstructured{},
-			component:   v1alpha1.PipelineResourceName,
-			expectError: true,
+			component:   testPipeline(),
+			expectError: false,
 		},
 		{
 			name: "Webhook endpoints are active",
@@ -52,7 +54,7 @@ func TestPreemptDeadlock(t *testing.T) {
 				namespacedResource("v1", "Service", "test-namespace", "tekton-pipelines-webhook"),
 				createWebhookConfig(),
 			},
-			component:   v1alpha1.PipelineResourceName,
+			component:   testPipeline(),
 			expectError: false,
 			endpoints: &v1.Endpoints{
 				ObjectMeta: metav1.ObjectMeta{
@@ -74,7 +76,7 @@ func TestPreemptDeadlock(t *testing.T) {
 				namespacedResource("v1", "Service", "test-namespace", "tekton-pipelines-webhook"),
 				createWebhookConfig(),
 			},
-			component:   v1alpha1.PipelineResourceName,
+			component:   testPipeline(),
 			expectError: false,
 			endpoints: &v1.Endpoints{
 				ObjectMeta: metav1.ObjectMeta{
@@ -125,10 +127,10 @@ func createWebhookConfig() unstructured.Unstructured {
 		},
 		Webhooks: []admissionregistrationv1.ValidatingWebhook{
 			{
-				Name: "config.webhook.triggers.tekton.dev",
+				Name: "config.webhook.pipeline.tekton.dev",
 				ClientConfig: admissionregistrationv1.WebhookClientConfig{
 					Service: &admissionregistrationv1.ServiceReference{
-						Name:      "tekton-triggers-webhook",
+						Name:      "tekton-pipelines-webhook",
 						Namespace: "tekton-pipelines",
 					},
 				},
@@ -152,3 +154,109 @@ func createWebhookConfig() unstructured.Unstructured {
 
 	return unstructured.Unstructured{Object: webhookConfigObj}
 }
+
+func TestPreemptDeadlock_ConfigWebhookRulesRemoved(t *testing.T) {
+	webhookConfig := createWebhookConfig()
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		namespacedResource("v1", "Service", "test-namespace", "tekton-pipelines-webhook"),
+		webhookConfig,
+	}), mf.UseClient(mff.New()))
+	assert.NilError(t, err)
+	// config webhook installed with its rules
+	assert.NilError(t, manifest.Filter(mf.ByKind("ValidatingWebhookConfiguration")).Apply())
+
+	err = PreemptDeadlock(context.TODO(), &manifest, k8sfake.NewSimpleClientset(), testPipeline())
+	assert.NilError(t, err)
+
+	existing, err := manifest.Client.Get(&webhookConfig)
+	assert.NilError(t, err)
+	_, found, _ := unstructured.NestedSlice(existing.Object, "webhooks")
+	assert.Equal(t, found, false)
+}
+
+func TestPreemptDeadlock_FailurePolicyRelaxedAndRestored(t *testing.T) {
+	webhookConfig := createMutatingWebhookConfig()
+	client := mff.New(webhookConfig.DeepCopy())
+	k8sClient := k8sfake.NewSimpleClientset()
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{
+		namespacedResource("v1", "Service", "test-namespace", "tekton-chains-webhook"),
+		webhookConfig,
+	}), mf.UseClient(client))
+	assert.NilError(t, err)
+
+	// endpoints are missing, failurePolicy is relaxed
+	err = PreemptDeadlock(context.TODO(), &manifest, k8sClient, testPipeline())
+	assert.NilError(t, err)
+
+	existing, err := client.Get(&webhookConfig)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, webhookFailurePolicies(existing), map[string]string{"webhook.chains.tekton.dev": "Ignore"})
+	assert.Equal(t, existing.GetAnnotations()[PreemptedFailurePoliciesKey], `{"webhook.chains.tekton.dev":"Fail"}`)
+
+	// endpoints are ready, failurePolicy is restored
+	_, err = k8sClient.CoreV1().Endpoints("test-namespace").Create(context.TODO(), &v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-chains-webhook", Namespace: "test-namespace"},
+		Subsets:    []v1.EndpointSubset{{Addresses: []v1.EndpointAddress{{IP: "1.2.3.4"}}}},
+	}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+
+	err = PreemptDeadlock(context.TODO(), &manifest, k8sClient, testPipeline())
+	assert.NilError(t, err)
+
+	existing, err = client.Get(&webhookConfig)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, webhookFailurePolicies(existing), map[string]string{"webhook.chains.tekton.dev": "Fail"})
+	_, found := existing.GetAnnotations()[PreemptedFailurePoliciesKey]
+	assert.Equal(t, found, false)
+}
+
+func testPipeline() *v1alpha1.TektonPipeline {
+	return &v1alpha1.TektonPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName},
+		Spec: v1alpha1.TektonPipelineSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "test-namespace"},
+		},
+	}
+}
+
+func webhookFailurePolicies(u *unstructured.Unstructured) map[string]string {
+	policies := map[string]string{}
+	for _, webhook := range webhooks(u) {
+		name, _, _ := unstructured.NestedString(webhook, "name")
+		policy, _, _ := unstructured.NestedString(webhook, "failurePolicy")
+		policies[name] = policy
+	}
+	return policies
+}
+
+func createMutatingWebhookConfig() unstructured.Unstructured {
+	failurePolicy := admissionregistrationv1.Fail
+	webhookConfig := &admissionregistrationv1.MutatingWebhookConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "admissionregistration.k8s.io/v1",
+			Kind:       "MutatingWebhookConfiguration",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "webhook.chains.tekton.dev",
+		},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{
+				Name:          "webhook.chains.tekton.dev",
+				FailurePolicy: &failurePolicy,
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Name:      "tekton-chains-webhook",
+						Namespace: "tekton-pipelines",
+					},
+				},
+			},
+		},
+	}
+
+	webhookConfigObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(webhookConfig)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to convert webhook config to unstructured: %v", err))
+	}
+
+	return unstructured.Unstructured{Object: webhookConfigObj}
+}
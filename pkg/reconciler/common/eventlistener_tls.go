@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	EventListenerTLSCertEnv = "TLS_CERT"
+	EventListenerTLSKeyEnv  = "TLS_KEY"
+)
+
+// EventListenerTLSSecretName returns the name of the secret holding the serving certificate
+// of the EventListener
+func EventListenerTLSSecretName(eventListener string) string {
+	return "el-" + eventListener + "-tls"
+}
+
+// EventListenerTLSEnv returns the env of the EventListener container, Triggers serves the
+// EventListener with TLS when they are set
+func EventListenerTLSEnv(eventListener string) []corev1.EnvVar {
+	secretKeyRef := func(key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: EventListenerTLSSecretName(eventListener)},
+				Key:                  key,
+			},
+		}
+	}
+	return []corev1.EnvVar{
+		{Name: EventListenerTLSCertEnv, ValueFrom: secretKeyRef(corev1.TLSCertKey)},
+		{Name: EventListenerTLSKeyEnv, ValueFrom: secretKeyRef(corev1.TLSPrivateKeyKey)},
+	}
+}
+
+// MergeEventListenerTLSEnv sets the TLS env of the EventListener in the env of its container
+func MergeEventListenerTLSEnv(env []corev1.EnvVar, eventListener string) []corev1.EnvVar {
+	result := append([]corev1.EnvVar{}, env...)
+	for _, tlsEnv := range EventListenerTLSEnv(eventListener) {
+		found := false
+		for i := range result {
+			if result[i].Name == tlsEnv.Name {
+				result[i] = tlsEnv
+				found = true
+			}
+		}
+		if !found {
+			result = append(result, tlsEnv)
+		}
+	}
+	return result
+}
+
+// RemoveEventListenerTLSEnv removes the TLS env from the env of the EventListener container
+func RemoveEventListenerTLSEnv(env []corev1.EnvVar) []corev1.EnvVar {
+	result := []corev1.EnvVar{}
+	for _, e := range env {
+		if e.Name == EventListenerTLSCertEnv || e.Name == EventListenerTLSKeyEnv {
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
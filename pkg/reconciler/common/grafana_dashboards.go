@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"golang.org/x/mod/semver"
+)
+
+// grafanaDashboardsDir holds the dashboards of the components by the first release they apply to,
+// eg. grafana-dashboards/tekton-pipeline/1.0.0/tekton-pipelines.json
+const grafanaDashboardsDir = "grafana-dashboards"
+
+// GrafanaDashboards returns the Grafana dashboards matching the release of the component bundled with
+// the operator, keyed by file name, along with the release. A component without dashboards for
+// its release returns none.
+func GrafanaDashboards(instance v1alpha1.TektonComponent) (string, map[string]string, error) {
+	releases, err := allReleases(instance)
+	if err != nil {
+		return "", nil, err
+	}
+	release := releases[0]
+
+	dir := filepath.Join(ComponentBaseDir(), grafanaDashboardsDir, filepath.Base(ComponentDir(instance)))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return release, nil, nil
+		}
+		return "", nil, err
+	}
+
+	versions := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && semver.IsValid(sanitizeSemver(entry.Name())) {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return semver.Compare(sanitizeSemver(versions[i]), sanitizeSemver(versions[j])) == 1
+	})
+
+	// the dashboards of the most recent version not after the release of the component
+	for _, version := range versions {
+		if semver.Compare(sanitizeSemver(version), sanitizeSemver(release)) > 0 {
+			continue
+		}
+		files, err := os.ReadDir(filepath.Join(dir, version))
+		if err != nil {
+			return "", nil, err
+		}
+		dashboards := map[string]string{}
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+				continue
+			}
+			content, err := os.ReadFile(filepath.Join(dir, version, file.Name()))
+			if err != nil {
+				return "", nil, err
+			}
+			dashboards[file.Name()] = string(content)
+		}
+		return release, dashboards, nil
+	}
+	return release, nil, nil
+}
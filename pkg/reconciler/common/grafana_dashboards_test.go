@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	util "github.com/tektoncd/operator/pkg/reconciler/common/testing"
+)
+
+func TestGrafanaDashboards(t *testing.T) {
+	t.Setenv(KoEnvKey, "testdata/kodata")
+
+	// the release 0.70.0 gets the dashboards of 0.65.0, the ones of 0.80.0 are too recent
+	release, dashboards, err := GrafanaDashboards(&v1alpha1.TektonPipeline{})
+	util.AssertEqual(t, err, nil)
+	util.AssertEqual(t, release, "0.70.0")
+	util.AssertDeepEqual(t, dashboards, map[string]string{
+		"tekton-pipelines.json":    `{"uid": "tekton-pipelines", "title": "Tekton / Pipelines", "version": "0.65.0"}` + "\n",
+		"tekton-pipelineruns.json": `{"uid": "tekton-pipelines-runs", "title": "Tekton / PipelineRuns", "version": "0.65.0"}` + "\n",
+	})
+
+	// a component without dashboards
+	release, dashboards, err = GrafanaDashboards(&v1alpha1.TektonTrigger{})
+	util.AssertEqual(t, err, nil)
+	util.AssertEqual(t, release, "0.15.2")
+	util.AssertEqual(t, len(dashboards), 0)
+
+	// a component without release
+	_, _, err = GrafanaDashboards(&v1alpha1.TektonChain{})
+	util.AssertEqual(t, err != nil, true)
+}
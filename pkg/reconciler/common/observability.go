@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/metrics"
+	"knative.dev/pkg/ptr"
+	"knative.dev/pkg/system"
+)
+
+const (
+	// observabilityConfigMap is part of the name of the observability ConfigMaps of the components,
+	// eg. config-observability-triggers or tekton-results-config-observability
+	observabilityConfigMap = "config-observability"
+	// pipelineTracingConfigMap is the tracing ConfigMap of the pipelines controller
+	pipelineTracingConfigMap = "config-tracing"
+
+	// the keys of the knative observability ConfigMap
+	metricsProtocolKey     = "metrics-protocol"
+	metricsEndpointKey     = "metrics-endpoint"
+	tracingProtocolKey     = "tracing-protocol"
+	tracingEndpointKey     = "tracing-endpoint"
+	tracingSamplingRateKey = "tracing-sampling-rate"
+
+	// otlpHeadersEnv is read by the OpenTelemetry exporters of the components
+	otlpHeadersEnv = "OTEL_EXPORTER_OTLP_HEADERS"
+	// otlpHeadersSecretKey is the key of the credentials secret holding the OTLP headers
+	otlpHeadersSecretKey = "headers"
+)
+
+// ObservabilityData returns the keys of the observability ConfigMaps rendered from the config,
+// the legacy metrics.backend-destination key is kept for the components not yet on OpenTelemetry
+func ObservabilityData(o *v1alpha1.Observability) map[string]string {
+	data := map[string]string{}
+	if o == nil {
+		return data
+	}
+	if m := o.Metrics; m != nil {
+		switch m.Backend {
+		case v1alpha1.MetricsBackendOTLP:
+			data[metricsProtocolKey] = otlpProtocol(m.Protocol)
+			data[metricsEndpointKey] = m.Endpoint
+		default:
+			data[metricsProtocolKey] = m.Backend
+			data[metrics.BackendDestinationKey] = m.Backend
+		}
+	}
+	if t := o.Tracing; t != nil {
+		data[tracingProtocolKey] = otlpProtocol(t.Protocol)
+		data[tracingEndpointKey] = t.Endpoint
+		if t.SamplingRate != nil {
+			data[tracingSamplingRateKey] = strconv.FormatFloat(*t.SamplingRate, 'f', -1, 64)
+		}
+	}
+	return data
+}
+
+func otlpProtocol(protocol string) string {
+	if protocol == "" {
+		return v1alpha1.OTLPProtocolGRPC
+	}
+	return protocol
+}
+
+// addObservability renders the config into the observability ConfigMaps of a component
+func addObservability(u *unstructured.Unstructured, o *v1alpha1.Observability) error {
+	if o == nil {
+		return nil
+	}
+	data := map[string]string{}
+	switch name := u.GetName(); {
+	case strings.Contains(name, observabilityConfigMap):
+		data = ObservabilityData(o)
+	case name == pipelineTracingConfigMap && o.Tracing != nil:
+		data["enabled"] = "true"
+		data["endpoint"] = o.Tracing.Endpoint
+		if o.CredentialsSecret != "" {
+			data["credentialsSecret"] = o.CredentialsSecret
+		}
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, cm); err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	for key, value := range data {
+		cm.Data[key] = value
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+	if err != nil {
+		return err
+	}
+	u.SetUnstructuredContent(obj)
+	return nil
+}
+
+// otlpHeaders returns the env var of the OTLP headers read from the credentials secret
+func otlpHeaders(o *v1alpha1.Observability) *corev1.EnvVar {
+	if o == nil || o.CredentialsSecret == "" {
+		return nil
+	}
+	return &corev1.EnvVar{
+		Name: otlpHeadersEnv,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: o.CredentialsSecret},
+				Key:                  otlpHeadersSecretKey,
+				Optional:             ptr.Bool(true),
+			},
+		},
+	}
+}
+
+// ReconcileOperatorObservability renders the config into the observability ConfigMap of the operator,
+// the keys are left as is without config
+func ReconcileOperatorObservability(ctx context.Context, kubeClientSet kubernetes.Interface, o *v1alpha1.Observability) error {
+	data := ObservabilityData(o)
+	if len(data) == 0 {
+		return nil
+	}
+	logger := logging.FromContext(ctx)
+
+	cm, err := kubeClientSet.CoreV1().ConfigMaps(system.Namespace()).Get(ctx, metrics.ConfigMapName(), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Warnw("observability ConfigMap of the operator not found", "name", metrics.ConfigMapName())
+			return nil
+		}
+		return err
+	}
+
+	changed := false
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	for key, value := range data {
+		if cm.Data[key] != value {
+			cm.Data[key] = value
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if _, err := kubeClientSet.CoreV1().ConfigMaps(system.Namespace()).Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update the observability ConfigMap %s of the operator: %w", cm.Name, err)
+	}
+	return nil
+}
+
+// setEnv adds the env var to the container, or replaces the existing one
+func setEnv(c *corev1.Container, env corev1.EnvVar) {
+	for i := range c.Env {
+		if c.Env[i].Name == env.Name {
+			c.Env[i] = env
+			return
+		}
+	}
+	c.Env = append(c.Env, env)
+}
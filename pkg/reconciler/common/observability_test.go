@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+	"knative.dev/pkg/system"
+)
+
+func TestObservabilityData(t *testing.T) {
+	tests := []struct {
+		name          string
+		observability *v1alpha1.Observability
+		expected      map[string]string
+	}{
+		{
+			name:     "no config",
+			expected: map[string]string{},
+		},
+		{
+			name: "prometheus",
+			observability: &v1alpha1.Observability{
+				Metrics: &v1alpha1.ObservabilityMetrics{Backend: v1alpha1.MetricsBackendPrometheus},
+			},
+			expected: map[string]string{
+				"metrics-protocol":            "prometheus",
+				"metrics.backend-destination": "prometheus",
+			},
+		},
+		{
+			name: "otlp",
+			observability: &v1alpha1.Observability{
+				Metrics: &v1alpha1.ObservabilityMetrics{Backend: v1alpha1.MetricsBackendOTLP, Endpoint: "http://otel-collector:4318", Protocol: v1alpha1.OTLPProtocolHTTP},
+				Tracing: &v1alpha1.ObservabilityTracing{Endpoint: "http://otel-collector:4317", SamplingRate: ptr.Float64(0.25)},
+			},
+			expected: map[string]string{
+				"metrics-protocol":      "http/protobuf",
+				"metrics-endpoint":      "http://otel-collector:4318",
+				"tracing-protocol":      "grpc",
+				"tracing-endpoint":      "http://otel-collector:4317",
+				"tracing-sampling-rate": "0.25",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.DeepEqual(t, ObservabilityData(test.observability), test.expected)
+		})
+	}
+}
+
+func TestAddConfiguration_Observability(t *testing.T) {
+	testData := path.Join("testdata", "test-add-configurations-observability.yaml")
+	manifest, err := mf.ManifestFrom(mf.Recursive(testData))
+	assert.NilError(t, err)
+
+	// the ConfigMaps are left untouched without config
+	unchanged, err := manifest.Filter(mf.ByKind("ConfigMap")).Transform(AddConfiguration(v1alpha1.Config{}))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, unchanged.Resources(), manifest.Filter(mf.ByKind("ConfigMap")).Resources())
+
+	config := v1alpha1.Config{
+		Observability: &v1alpha1.Observability{
+			Metrics:           &v1alpha1.ObservabilityMetrics{Backend: v1alpha1.MetricsBackendOTLP, Endpoint: "http://otel-collector:4317"},
+			Tracing:           &v1alpha1.ObservabilityTracing{Endpoint: "http://otel-collector:4317"},
+			CredentialsSecret: "otlp-credentials",
+		},
+	}
+	transformed, err := manifest.Transform(AddConfiguration(config))
+	assert.NilError(t, err)
+
+	d := &appsv1.Deployment{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(transformed.Filter(mf.ByKind("Deployment")).Resources()[0].Object, d))
+	assert.DeepEqual(t, d.Spec.Template.Spec.Containers[0].Env, []corev1.EnvVar{{
+		Name: "OTEL_EXPORTER_OTLP_HEADERS",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "otlp-credentials"},
+			Key:                  "headers",
+			Optional:             ptr.Bool(true),
+		}},
+	}})
+
+	configMapData := func(name string) map[string]string {
+		cm := &corev1.ConfigMap{}
+		assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(transformed.Filter(mf.ByName(name)).Resources()[0].Object, cm))
+		return cm.Data
+	}
+	assert.DeepEqual(t, configMapData("config-observability-triggers"), map[string]string{
+		"metrics.backend-destination":                 "prometheus",
+		"metrics.request-metrics-backend-destination": "prometheus",
+		"metrics-protocol":                            "grpc",
+		"metrics-endpoint":                            "http://otel-collector:4317",
+		"tracing-protocol":                            "grpc",
+		"tracing-endpoint":                            "http://otel-collector:4317",
+	})
+	assert.DeepEqual(t, configMapData("config-tracing"), map[string]string{
+		"_example":          "enabled: \"false\"\n",
+		"enabled":           "true",
+		"endpoint":          "http://otel-collector:4317",
+		"credentialsSecret": "otlp-credentials",
+	})
+	assert.DeepEqual(t, configMapData("config-logging-triggers"), map[string]string{
+		"loglevel.controller": "info",
+	})
+}
+
+func TestReconcileOperatorObservability(t *testing.T) {
+	t.Setenv(system.NamespaceEnvKey, "tekton-operator")
+	t.Setenv("CONFIG_OBSERVABILITY_NAME", "tekton-config-observability")
+	ctx := context.Background()
+
+	kubeClient := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-config-observability", Namespace: "tekton-operator"},
+		Data:       map[string]string{"_example": "metrics.backend-destination: prometheus"},
+	})
+
+	// without config the ConfigMap is left as is
+	assert.NilError(t, ReconcileOperatorObservability(ctx, kubeClient, nil))
+	assert.Equal(t, len(kubeClient.Actions()), 0)
+
+	observability := &v1alpha1.Observability{
+		Metrics: &v1alpha1.ObservabilityMetrics{Backend: v1alpha1.MetricsBackendNone},
+	}
+	assert.NilError(t, ReconcileOperatorObservability(ctx, kubeClient, observability))
+	cm, err := kubeClient.CoreV1().ConfigMaps("tekton-operator").Get(ctx, "tekton-config-observability", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cm.Data, map[string]string{
+		"_example":                    "metrics.backend-destination: prometheus",
+		"metrics-protocol":            "none",
+		"metrics.backend-destination": "none",
+	})
+
+	// an up to date ConfigMap is not updated
+	kubeClient.ClearActions()
+	assert.NilError(t, ReconcileOperatorObservability(ctx, kubeClient, observability))
+	for _, action := range kubeClient.Actions() {
+		assert.Equal(t, action.GetVerb(), "get")
+	}
+}
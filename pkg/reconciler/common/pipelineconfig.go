@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// exampleKey is the data key documenting the keys of a knative style ConfigMap
+const exampleKey = "_example"
+
+// exampleLine matches an uncommented `key: "value"` line of an _example block
+var exampleLine = regexp.MustCompile(`^([a-zA-Z0-9._-]+):\s*"?([^"#]*)"?\s*(#.*)?$`)
+
+// PipelineConfigKeys returns the keys of the feature-flags and config-defaults ConfigMaps of
+// the latest Pipelines release bundled with the operator, with the kind of their defaults
+func PipelineConfigKeys() (v1alpha1.PipelineConfigKeys, error) {
+	releases, err := allReleases(&v1alpha1.TektonPipeline{})
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := FetchRecursive(manifestPath(releases[0], &v1alpha1.TektonPipeline{}))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := v1alpha1.PipelineConfigKeys{}
+	for _, name := range []string{v1alpha1.PipelineFeatureFlagsConfigMap, v1alpha1.PipelineConfigDefaultsConfigMap} {
+		resources := manifest.Filter(mf.ByKind("ConfigMap"), mf.ByName(name)).Resources()
+		if len(resources) == 0 {
+			return nil, fmt.Errorf("ConfigMap %s not found in the Pipelines release %s", name, releases[0])
+		}
+		cm := &corev1.ConfigMap{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(resources[0].Object, cm); err != nil {
+			return nil, err
+		}
+		keys[name] = configMapKeys(cm.Data)
+	}
+	return keys, nil
+}
+
+// configMapKeys returns the keys of the data and the keys documented in its _example block
+func configMapKeys(data map[string]string) map[string]v1alpha1.ConfigValueKind {
+	keys := map[string]v1alpha1.ConfigValueKind{}
+	for _, line := range strings.Split(data[exampleKey], "\n") {
+		if match := exampleLine.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			keys[match[1]] = v1alpha1.ConfigValueKindOf(strings.TrimSpace(match[2]))
+		}
+	}
+	for key, value := range data {
+		if key != exampleKey {
+			keys[key] = v1alpha1.ConfigValueKindOf(value)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	util "github.com/tektoncd/operator/pkg/reconciler/common/testing"
+)
+
+func TestPipelineConfigKeys(t *testing.T) {
+	t.Setenv(KoEnvKey, "testdata/kodata")
+
+	keys, err := PipelineConfigKeys()
+	util.AssertEqual(t, err, nil)
+	util.AssertDeepEqual(t, keys, v1alpha1.PipelineConfigKeys{
+		v1alpha1.PipelineFeatureFlagsConfigMap: {
+			"disable-creds-init": v1alpha1.ConfigValueBool,
+			"enable-api-fields":  v1alpha1.ConfigValueString,
+			"max-result-size":    v1alpha1.ConfigValueInt,
+		},
+		v1alpha1.PipelineConfigDefaultsConfigMap: {
+			"default-timeout-minutes": v1alpha1.ConfigValueInt,
+			"default-service-account": v1alpha1.ConfigValueString,
+		},
+	})
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TektonProfile returns the spec of the profile of a TektonConfig, either a builtin
+// profile or a TektonProfile, an empty profile is the default basic profile
+func TektonProfile(ctx context.Context, operatorClientSet versioned.Interface, profile string) (v1alpha1.TektonProfileSpec, error) {
+	if profile == "" {
+		profile = v1alpha1.ProfileBasic
+	}
+	if spec, ok := v1alpha1.BuiltinProfile(profile); ok {
+		return spec, nil
+	}
+	tp, err := operatorClientSet.OperatorV1alpha1().TektonProfiles().Get(ctx, profile, metav1.GetOptions{})
+	if err != nil {
+		return v1alpha1.TektonProfileSpec{}, fmt.Errorf("failed to get the TektonProfile %q: %w", profile, err)
+	}
+	return tp.Spec, nil
+}
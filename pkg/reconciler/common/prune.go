@@ -257,23 +257,24 @@ func (pr *Pruner) reconcileCronJobs(ctx context.Context) error {
 	return nil
 }
 
-// to compute hash include, pruneConfigs, startingDeadlineSeconds, nodeSelector, toleration, priorityClass
+// config returns the config of the CronJobs, the global config with the override of the pruner
+func (pr *Pruner) config() v1alpha1.Config {
+	return pr.tektonConfig.Spec.Config.WithOverride(pr.tektonConfig.Spec.ComponentConfig.Pruner)
+}
+
+// to compute hash include, pruneConfigs, startingDeadlineSeconds and the config
 func (pr *Pruner) computeHash(pruneConfigs []pruneConfig) (string, error) {
-	// to compute hash additionally include, nodeSelector, tolerations, priorityClassName
-	// to update cronjobs if there is a change on those fields
+	// to compute hash additionally include the config
+	// to update cronjobs if there is a change on it
 	targetObject := struct {
 		PruneConfigs            []pruneConfig
 		StartingDeadlineSeconds *int64
-		NodeSelector            map[string]string
-		Tolerations             []corev1.Toleration
-		PriorityClassName       string
+		Config                  v1alpha1.Config
 		Script                  string
 	}{
-		PruneConfigs:      pruneConfigs,
-		NodeSelector:      pr.tektonConfig.Spec.Config.NodeSelector,
-		Tolerations:       pr.tektonConfig.Spec.Config.Tolerations,
-		PriorityClassName: pr.tektonConfig.Spec.Config.PriorityClassName,
-		Script:            prunerCommand,
+		PruneConfigs: pruneConfigs,
+		Config:       pr.config(),
+		Script:       prunerCommand,
 	}
 	// update StartingDeadlineSeconds
 	if pr.tektonConfig.Spec.Pruner.StartingDeadlineSeconds != nil {
@@ -567,9 +568,6 @@ func (pr *Pruner) createCronJobs(ctx context.Context, cronJobsToBeCreated map[st
 								}},
 								RestartPolicy:      corev1.RestartPolicyNever,
 								ServiceAccountName: prunerServiceAccountName,
-								NodeSelector:       pr.tektonConfig.Spec.Config.NodeSelector,
-								Tolerations:        pr.tektonConfig.Spec.Config.Tolerations,
-								PriorityClassName:  pr.tektonConfig.Spec.Config.PriorityClassName,
 								SecurityContext: &corev1.PodSecurityContext{
 									RunAsNonRoot: &runAsNonRoot,
 									SeccompProfile: &corev1.SeccompProfile{
@@ -585,6 +583,14 @@ func (pr *Pruner) createCronJobs(ctx context.Context, cronJobsToBeCreated map[st
 			},
 		}
 
+		if err := ApplyJobConfig(&cronJob.Spec.JobTemplate.Spec.Template, pr.config()); err != nil {
+			pr.logger.Errorw("error on applying the config to a cron job",
+				"namespace", cronJob.GetNamespace(),
+				"error", err,
+			)
+			continue
+		}
+
 		// create a cron job
 		_, err := pr.kubeClientset.BatchV1().CronJobs(pr.targetNamespace).Create(ctx, cronJob, metav1.CreateOptions{})
 		if err != nil {
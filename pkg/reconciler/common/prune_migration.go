@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pruner/pkg/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/ptr"
+)
+
+// TranslatePrunerConfig translates the settings of the job based pruner (spec.pruner) and the
+// operator.tekton.dev/prune.* namespace annotations into the global config of the event based pruner.
+// keep maps to historyLimit and keep-since to ttlSecondsAfterFinished, namespaces with their own
+// prune settings get a namespace spec. The returned notes list the settings which could not be translated
+func TranslatePrunerConfig(ctx context.Context, k kubernetes.Interface, tc *v1alpha1.TektonConfig) (*config.GlobalConfig, []string, error) {
+	notes := []string{}
+	jobPruner := tc.Spec.Pruner
+
+	keep := jobPruner.Keep
+	if keep == nil && jobPruner.KeepSince == nil {
+		defaultKeep := v1alpha1.PrunerDefaultKeep
+		keep = &defaultKeep
+	}
+
+	globalConfig := &config.GlobalConfig{
+		PrunerConfig: config.PrunerConfig{
+			HistoryLimit:            historyLimit(keep),
+			TTLSecondsAfterFinished: ttlSecondsAfterFinished(jobPruner.KeepSince),
+		},
+		Namespaces: map[string]config.NamespaceSpec{},
+	}
+	enforcedConfigLevel := config.EnforcedConfigLevelGlobal
+	globalConfig.EnforcedConfigLevel = &enforcedConfigLevel
+
+	if jobPruner.Schedule == "" {
+		notes = append(notes, "the global schedule is empty, the event based pruner prunes the namespaces without a schedule annotation too")
+	} else {
+		notes = append(notes, fmt.Sprintf("schedule %q is dropped, the event based pruner prunes the resources once they are completed", jobPruner.Schedule))
+	}
+	if note := resourcesNote(jobPruner.Resources); note != "" {
+		notes = append(notes, note)
+	}
+	if jobPruner.PrunePerResource {
+		notes = append(notes, "prune-per-resource has no equivalent, the history limit applies to all the runs of the namespace")
+	}
+	if jobPruner.StartingDeadlineSeconds != nil {
+		notes = append(notes, "startingDeadlineSeconds is dropped as there is no cron job anymore")
+	}
+
+	namespaces, err := k.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(namespaces.Items, func(i, j int) bool {
+		return namespaces.Items[i].GetName() < namespaces.Items[j].GetName()
+	})
+
+	ignorePattern := regexp.MustCompile(NamespaceIgnorePattern)
+	for _, namespace := range namespaces.Items {
+		if ignorePattern.MatchString(namespace.GetName()) {
+			continue
+		}
+		nsSpec, nsNotes := translateNamespacePruneAnnotations(&namespace, globalConfig.PrunerConfig)
+		notes = append(notes, nsNotes...)
+		if nsSpec != nil {
+			globalConfig.Namespaces[namespace.GetName()] = *nsSpec
+		}
+	}
+	return globalConfig, notes, nil
+}
+
+// translateNamespacePruneAnnotations returns the namespace spec for the prune annotations of a namespace,
+// nil if the namespace does not have its own settings
+func translateNamespacePruneAnnotations(namespace *corev1.Namespace, global config.PrunerConfig) (*config.NamespaceSpec, []string) {
+	name := namespace.GetName()
+	annotations := namespace.GetAnnotations()
+	notes := []string{}
+
+	if annotations[pruneAnnotationSkip] == "true" {
+		notes = append(notes, fmt.Sprintf("namespace %s: %s has no equivalent, the namespace is pruned with the global settings", name, pruneAnnotationSkip))
+		return nil, notes
+	}
+	if value, found := annotations[pruneAnnotationResources]; found {
+		if note := resourcesNote(strings.Split(value, ",")); note != "" {
+			notes = append(notes, fmt.Sprintf("namespace %s: %s", name, note))
+		}
+	}
+	if annotations[pruneAnnotationPrunePerResource] == "true" {
+		notes = append(notes, fmt.Sprintf("namespace %s: %s has no equivalent", name, pruneAnnotationPrunePerResource))
+	}
+
+	_, hasSchedule := annotations[pruneAnnotationSchedule]
+	keepValue, hasKeep := annotations[pruneAnnotationKeep]
+	keepSinceValue, hasKeepSince := annotations[pruneAnnotationKeepSince]
+	strategy, hasStrategy := annotations[pruneAnnotationStrategy]
+	if !hasSchedule && !hasKeep && !hasKeepSince && !hasStrategy {
+		return nil, notes
+	}
+
+	// namespaces with their own schedule get the effective settings, even if they are the same as the global ones
+	nsSpec := &config.NamespaceSpec{
+		PrunerConfig: config.PrunerConfig{
+			HistoryLimit:            global.HistoryLimit,
+			TTLSecondsAfterFinished: global.TTLSecondsAfterFinished,
+		},
+	}
+
+	if hasKeep {
+		keep, err := strconv.ParseUint(keepValue, 10, 32)
+		if err != nil {
+			notes = append(notes, fmt.Sprintf("namespace %s: invalid %s value %q is dropped", name, pruneAnnotationKeep, keepValue))
+		} else {
+			nsSpec.HistoryLimit = historyLimit(ptrUint(uint(keep)))
+		}
+	}
+	if hasKeepSince {
+		keepSince, err := strconv.ParseUint(keepSinceValue, 10, 32)
+		if err != nil {
+			notes = append(notes, fmt.Sprintf("namespace %s: invalid %s value %q is dropped", name, pruneAnnotationKeepSince, keepSinceValue))
+		} else {
+			nsSpec.TTLSecondsAfterFinished = ttlSecondsAfterFinished(ptrUint(uint(keepSince)))
+		}
+	}
+
+	switch strategy {
+	case pruneStrategyKeep:
+		if nsSpec.TTLSecondsAfterFinished != nil {
+			notes = append(notes, fmt.Sprintf("namespace %s: strategy %s can not disable the inherited ttlSecondsAfterFinished", name, strategy))
+		}
+	case pruneStrategyKeepSince:
+		if nsSpec.HistoryLimit != nil {
+			notes = append(notes, fmt.Sprintf("namespace %s: strategy %s can not disable the inherited historyLimit", name, strategy))
+		}
+	case "":
+	default:
+		notes = append(notes, fmt.Sprintf("namespace %s: invalid %s value %q is dropped", name, pruneAnnotationStrategy, strategy))
+	}
+
+	return nsSpec, notes
+}
+
+// resourcesNote reports the resources selection which can not be translated,
+// the event based pruner prunes both PipelineRuns and TaskRuns
+func resourcesNote(resources []string) string {
+	if len(resources) == 0 {
+		return ""
+	}
+	selected := map[string]bool{}
+	for _, resource := range resources {
+		if normalized, found := pruneResourceNameMap[strings.ToLower(strings.TrimSpace(resource))]; found {
+			selected[normalized] = true
+		}
+	}
+	if selected["pipelinerun"] && selected["taskrun"] {
+		return ""
+	}
+	return fmt.Sprintf("resources %q can not be translated, both pipelineruns and taskruns are pruned", strings.Join(resources, ","))
+}
+
+func historyLimit(keep *uint) *int32 {
+	if keep == nil {
+		return nil
+	}
+	if *keep > math.MaxInt32 {
+		return ptr.Int32(math.MaxInt32)
+	}
+	return ptr.Int32(int32(*keep))
+}
+
+// ttlSecondsAfterFinished converts the keep-since value, in minutes, to seconds
+func ttlSecondsAfterFinished(keepSince *uint) *int32 {
+	if keepSince == nil {
+		return nil
+	}
+	if *keepSince > math.MaxInt32/60 {
+		return ptr.Int32(math.MaxInt32)
+	}
+	return ptr.Int32(int32(*keepSince * 60))
+}
+
+func ptrUint(value uint) *uint {
+	return &value
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/pruner/pkg/config"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+)
+
+func TestTranslatePrunerConfig(t *testing.T) {
+	keep := uint(10)
+	namespaces := []corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "kube-system", Annotations: map[string]string{pruneAnnotationKeep: "1"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-keep", Annotations: map[string]string{pruneAnnotationKeep: "3"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-keep-since", Annotations: map[string]string{
+			pruneAnnotationKeepSince: "120",
+			pruneAnnotationStrategy:  pruneStrategyKeepSince,
+		}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-schedule", Annotations: map[string]string{pruneAnnotationSchedule: "*/5 * * * *"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-skip", Annotations: map[string]string{pruneAnnotationSkip: "true"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-invalid", Annotations: map[string]string{
+			pruneAnnotationKeep:      "many",
+			pruneAnnotationResources: "taskrun",
+		}}},
+	}
+	kubeClient := fake.NewSimpleClientset()
+	for index := range namespaces {
+		_, err := kubeClient.CoreV1().Namespaces().Create(context.TODO(), &namespaces[index], metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}
+
+	tc := &v1alpha1.TektonConfig{
+		Spec: v1alpha1.TektonConfigSpec{
+			Pruner: v1alpha1.Prune{
+				Keep:      &keep,
+				Schedule:  "0 8 * * *",
+				Resources: []string{"pipelinerun", "taskrun"},
+			},
+		},
+	}
+
+	globalConfig, notes, err := TranslatePrunerConfig(context.TODO(), kubeClient, tc)
+	assert.NilError(t, err)
+
+	enforcedConfigLevel := config.EnforcedConfigLevelGlobal
+	expected := &config.GlobalConfig{
+		PrunerConfig: config.PrunerConfig{
+			EnforcedConfigLevel: &enforcedConfigLevel,
+			HistoryLimit:        ptr.Int32(10),
+		},
+		Namespaces: map[string]config.NamespaceSpec{
+			"ns-keep": {PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(3)}},
+			"ns-keep-since": {PrunerConfig: config.PrunerConfig{
+				HistoryLimit:            ptr.Int32(10),
+				TTLSecondsAfterFinished: ptr.Int32(7200),
+			}},
+			"ns-schedule": {PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(10)}},
+			"ns-invalid":  {PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(10)}},
+		},
+	}
+	assert.DeepEqual(t, globalConfig, expected)
+
+	assert.DeepEqual(t, notes, []string{
+		`schedule "0 8 * * *" is dropped, the event based pruner prunes the resources once they are completed`,
+		`namespace ns-invalid: resources "taskrun" can not be translated, both pipelineruns and taskruns are pruned`,
+		`namespace ns-invalid: invalid operator.tekton.dev/prune.keep value "many" is dropped`,
+		`namespace ns-keep-since: strategy keep-since can not disable the inherited historyLimit`,
+		`namespace ns-skip: operator.tekton.dev/prune.skip has no equivalent, the namespace is pruned with the global settings`,
+	})
+}
+
+func TestTranslatePrunerConfig_KeepSince(t *testing.T) {
+	keepSince := uint(60)
+	tc := &v1alpha1.TektonConfig{
+		Spec: v1alpha1.TektonConfigSpec{
+			Pruner: v1alpha1.Prune{KeepSince: &keepSince, Schedule: "0 8 * * *"},
+		},
+	}
+
+	globalConfig, _, err := TranslatePrunerConfig(context.TODO(), fake.NewSimpleClientset(), tc)
+	assert.NilError(t, err)
+	assert.Assert(t, globalConfig.HistoryLimit == nil)
+	assert.Equal(t, *globalConfig.TTLSecondsAfterFinished, int32(3600))
+}
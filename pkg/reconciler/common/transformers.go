@@ -18,6 +18,7 @@ package common
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
@@ -698,23 +699,52 @@ func InjectLabelOnNamespace(label string) mf.Transformer {
 	}
 }
 
+// AddConfiguration applies the config to the pod template of the Deployments, StatefulSets, Jobs
+// and CronJobs, and renders its observability into the observability ConfigMaps
 func AddConfiguration(config v1alpha1.Config) mf.Transformer {
 	return func(u *unstructured.Unstructured) error {
-		if u.GetKind() != "Deployment" {
+		var obj interface{}
+		var template *corev1.PodTemplateSpec
+		switch u.GetKind() {
+		case "ConfigMap":
+			return addObservability(u, config.Observability)
+		case "Deployment":
+			d := &appsv1.Deployment{}
+			obj, template = d, &d.Spec.Template
+		case "StatefulSet":
+			sts := &appsv1.StatefulSet{}
+			obj, template = sts, &sts.Spec.Template
+		case "Job":
+			job := &batchv1.Job{}
+			obj, template = job, &job.Spec.Template
+		case "CronJob":
+			cronJob := &batchv1.CronJob{}
+			obj, template = cronJob, &cronJob.Spec.JobTemplate.Spec.Template
+		default:
+			return nil
+		}
+		// the statefulSets and jobs are left untouched without config
+		if u.GetKind() != "Deployment" && reflect.DeepEqual(config, v1alpha1.Config{}) {
 			return nil
 		}
 
-		d := &appsv1.Deployment{}
-		err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d)
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
 		if err != nil {
 			return err
 		}
 
-		d.Spec.Template.Spec.NodeSelector = config.NodeSelector
-		d.Spec.Template.Spec.Tolerations = config.Tolerations
-		d.Spec.Template.Spec.PriorityClassName = config.PriorityClassName
+		if u.GetKind() == "Deployment" {
+			template.Spec.NodeSelector = config.NodeSelector
+			template.Spec.Tolerations = config.Tolerations
+			template.Spec.PriorityClassName = config.PriorityClassName
+			if err := applyPodConfig(template, config); err != nil {
+				return err
+			}
+		} else if err := ApplyJobConfig(template, config); err != nil {
+			return err
+		}
 
-		unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(d)
+		unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
 		if err != nil {
 			return err
 		}
@@ -724,6 +754,136 @@ func AddConfiguration(config v1alpha1.Config) mf.Transformer {
 	}
 }
 
+// ApplyJobConfig applies the config to the pod template of a StatefulSet, a Job or a CronJob, the
+// scheduling of the template is kept when the config does not set it
+func ApplyJobConfig(template *corev1.PodTemplateSpec, config v1alpha1.Config) error {
+	if config.NodeSelector != nil {
+		template.Spec.NodeSelector = config.NodeSelector
+	}
+	if config.Tolerations != nil {
+		template.Spec.Tolerations = config.Tolerations
+	}
+	if config.PriorityClassName != "" {
+		template.Spec.PriorityClassName = config.PriorityClassName
+	}
+	return applyPodConfig(template, config)
+}
+
+// applyPodConfig applies the workload policy of the config to a pod template
+func applyPodConfig(template *corev1.PodTemplateSpec, config v1alpha1.Config) error {
+	spec := &template.Spec
+
+	for _, secret := range config.ImagePullSecrets {
+		if !slices.Contains(spec.ImagePullSecrets, secret) {
+			spec.ImagePullSecrets = append(spec.ImagePullSecrets, secret)
+		}
+	}
+
+	// the labels of the manifest are kept, they can be used by the selector
+	if len(config.PodLabels) > 0 {
+		labels := template.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		for key, value := range config.PodLabels {
+			if _, found := labels[key]; !found {
+				labels[key] = value
+			}
+		}
+		template.SetLabels(labels)
+	}
+
+	if len(config.PodAnnotations) > 0 {
+		annotations := template.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		for key, value := range config.PodAnnotations {
+			annotations[key] = value
+		}
+		template.SetAnnotations(annotations)
+	}
+
+	if config.PodSecurityContext != nil {
+		if spec.SecurityContext == nil {
+			spec.SecurityContext = &corev1.PodSecurityContext{}
+		}
+		if err := mergeFields(spec.SecurityContext, config.PodSecurityContext); err != nil {
+			return err
+		}
+	}
+
+	if config.Affinity != nil {
+		spec.Affinity = config.Affinity.DeepCopy()
+	}
+
+	if len(config.TopologySpreadConstraints) > 0 {
+		spec.TopologySpreadConstraints = append([]corev1.TopologySpreadConstraint{}, config.TopologySpreadConstraints...)
+	}
+
+	if env := otlpHeaders(config.Observability); env != nil {
+		for i := range spec.Containers {
+			setEnv(&spec.Containers[i], *env)
+		}
+	}
+
+	for _, containers := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			c := &containers[i]
+			if config.ContainerSecurityContext != nil {
+				if c.SecurityContext == nil {
+					c.SecurityContext = &corev1.SecurityContext{}
+				}
+				if err := mergeFields(c.SecurityContext, config.ContainerSecurityContext); err != nil {
+					return err
+				}
+			}
+			if config.DefaultResources != nil {
+				setDefaultResources(&c.Resources, *config.DefaultResources)
+			}
+		}
+	}
+	return nil
+}
+
+// mergeFields sets on dst the fields set on src, the nested structs are merged field by field
+func mergeFields(dst, src interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// setDefaultResources sets the default requests and limits the container does not define,
+// a default which would put a request above its limit is skipped
+func setDefaultResources(resources *corev1.ResourceRequirements, defaults corev1.ResourceRequirements) {
+	for name, request := range defaults.Requests {
+		if _, found := resources.Requests[name]; found {
+			continue
+		}
+		if limit, found := resources.Limits[name]; found && request.Cmp(limit) > 0 {
+			continue
+		}
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
+		}
+		resources.Requests[name] = request.DeepCopy()
+	}
+	for name, limit := range defaults.Limits {
+		if _, found := resources.Limits[name]; found {
+			continue
+		}
+		if request, found := resources.Requests[name]; found && request.Cmp(limit) > 0 {
+			continue
+		}
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
+		resources.Limits[name] = limit.DeepCopy()
+	}
+}
+
 // AddDeploymentRestrictedPSA will add the default restricted spec on Deployment to remove errors/warning
 func AddDeploymentRestrictedPSA() mf.Transformer {
 	return func(u *unstructured.Unstructured) error {
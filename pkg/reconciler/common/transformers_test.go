@@ -35,8 +35,10 @@ import (
 	"gotest.tools/v3/assert"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/apps/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -992,6 +994,74 @@ func TestAddConfiguration(t *testing.T) {
 	assert.Equal(t, d.Spec.Template.Spec.PriorityClassName, config.PriorityClassName)
 }
 
+func TestAddConfiguration_WorkloadPolicy(t *testing.T) {
+	testData := path.Join("testdata", "test-add-configurations-workloads.yaml")
+	manifest, err := mf.ManifestFrom(mf.Recursive(testData))
+	assert.NilError(t, err)
+
+	// the statefulSets and jobs are left untouched without config
+	unchanged, err := manifest.Transform(AddConfiguration(v1alpha1.Config{}))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, unchanged.Resources(), manifest.Resources())
+
+	config := v1alpha1.Config{
+		NodeSelector:     map[string]string{"node": "infra"},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}, {Name: "mirror"}},
+		PodLabels:        map[string]string{"app": "override", "team": "ci"},
+		PodAnnotations:   map[string]string{"sidecar.istio.io/inject": "false"},
+		PodSecurityContext: &corev1.PodSecurityContext{
+			RunAsNonRoot: ptr.Bool(true),
+		},
+		ContainerSecurityContext: &corev1.SecurityContext{
+			ReadOnlyRootFilesystem: ptr.Bool(true),
+		},
+		Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}},
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+			{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: corev1.ScheduleAnyway},
+		},
+		DefaultResources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+			},
+		},
+	}
+	manifest, err = manifest.Transform(AddConfiguration(config))
+	assert.NilError(t, err)
+
+	sts := &appsv1.StatefulSet{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, sts))
+	pod := sts.Spec.Template
+	assert.DeepEqual(t, pod.Spec.NodeSelector, config.NodeSelector)
+	assert.DeepEqual(t, pod.Spec.ImagePullSecrets, config.ImagePullSecrets)
+	// the labels of the manifest are kept
+	assert.DeepEqual(t, pod.Labels, map[string]string{"app": "postgres", "team": "ci"})
+	assert.DeepEqual(t, pod.Spec.SecurityContext, config.PodSecurityContext)
+	assert.DeepEqual(t, pod.Spec.Affinity, config.Affinity)
+	assert.DeepEqual(t, pod.Spec.TopologySpreadConstraints, config.TopologySpreadConstraints)
+	// the security context of the container is merged
+	assert.DeepEqual(t, pod.Spec.Containers[0].SecurityContext, &corev1.SecurityContext{
+		RunAsUser:              ptr.Int64(1000),
+		ReadOnlyRootFilesystem: ptr.Bool(true),
+	})
+	// a default request above the limit of the container is skipped
+	assert.DeepEqual(t, pod.Spec.Containers[0].Resources, corev1.ResourceRequirements{
+		Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+		Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+	})
+
+	job := &batchv1.Job{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[1].Object, job))
+	pod = job.Spec.Template
+	assert.DeepEqual(t, pod.Annotations, map[string]string{"sidecar.istio.io/inject": "false"})
+	assert.DeepEqual(t, pod.Spec.InitContainers[0].SecurityContext, config.ContainerSecurityContext)
+	assert.DeepEqual(t, pod.Spec.InitContainers[0].Resources, *config.DefaultResources)
+	assert.DeepEqual(t, pod.Spec.Containers[0].Resources, *config.DefaultResources)
+
+	// other kinds are not changed
+	assert.DeepEqual(t, manifest.Resources()[2], unchanged.Resources()[2])
+}
+
 func TestAddPSA(t *testing.T) {
 	testData := path.Join("testdata", "test-add-psa.yaml")
 	manifest, err := mf.ManifestFrom(mf.Recursive(testData))
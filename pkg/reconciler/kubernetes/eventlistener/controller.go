@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventlistener
+
+import (
+	"context"
+
+	"github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektontrigger"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	mwhinformer "knative.dev/pkg/client/injection/kube/informers/admissionregistration/v1/mutatingwebhookconfiguration"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+)
+
+func NewEventListenerDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+
+	return NewAdmissionController(ctx,
+
+		// Name of the resource webhook.
+		"eventlistener.operator.tekton.dev",
+
+		// The path on which to serve the webhook.
+		"/eventlistener-defaulting",
+
+		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
+	)
+}
+
+// NewAdmissionController constructs a reconciler
+func NewAdmissionController(
+	ctx context.Context,
+	name, path string,
+	wc func(context.Context) context.Context,
+) *controller.Impl {
+
+	client := kubeclient.Get(ctx)
+	mwhInformer := mwhinformer.Get(ctx)
+	secretInformer := secretinformer.Get(ctx)
+	options := webhook.GetOptions(ctx)
+	tektonTriggerInformer := tektontrigger.Get(ctx)
+
+	key := types.NamespacedName{Name: name}
+
+	wh := &reconciler{
+		LeaderAwareFuncs: pkgreconciler.LeaderAwareFuncs{
+			// Have this reconciler enqueue our singleton whenever it becomes leader.
+			PromoteFunc: func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+				enq(bkt, key)
+				return nil
+			},
+		},
+
+		key:  key,
+		path: path,
+
+		withContext: wc,
+		secretName:  options.SecretName,
+
+		client:              client,
+		mwhlister:           mwhInformer.Lister(),
+		secretlister:        secretInformer.Lister(),
+		tektonTriggerLister: tektonTriggerInformer.Lister(),
+	}
+
+	logger := logging.FromContext(ctx)
+	c := controller.NewContext(ctx, wh, controller.ControllerOptions{WorkQueueName: "EventListenerDefaultingWebhook", Logger: logger})
+
+	// Reconcile when the named MutatingWebhookConfiguration changes.
+	if _, err := mwhInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithName(name),
+		// It doesn't matter what we enqueue because we will always Reconcile
+		// the named MWH resource.
+		Handler: controller.HandleAll(c.Enqueue),
+	}); err != nil {
+		logger.Panicf("Couldn't register MutatingWebhookConfugration informer event handler: %w", err)
+	}
+
+	// Reconcile when the cert bundle changes.
+	if _, err := secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithNameAndNamespace(system.Namespace(), wh.secretName),
+		// It doesn't matter what we enqueue because we will always Reconcile
+		// the named MWH resource.
+		Handler: controller.HandleAll(c.Enqueue),
+	}); err != nil {
+		logger.Panicf("Couldn't register Secret informer event handler: %w", err)
+	}
+
+	// Reconcile when eventListenerTLS changes, the rules of the webhook select the EventListeners
+	// of the namespaces selected by eventListenerTLS.
+	if _, err := tektonTriggerInformer.Informer().AddEventHandler(controller.HandleAll(func(interface{}) {
+		c.EnqueueKey(key)
+	})); err != nil {
+		logger.Panicf("Couldn't register TektonTrigger informer event handler: %w", err)
+	}
+
+	return c
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventlistener
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorlisters "github.com/tektoncd/operator/pkg/client/listers/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"go.uber.org/zap"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	admissionlisters "k8s.io/client-go/listers/admissionregistration/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmp"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+// reconciler implements the AdmissionController for EventListeners, it sets the TLS env of the
+// EventListeners of the namespaces selected by the eventListenerTLS of TektonTrigger
+type reconciler struct {
+	webhook.StatelessAdmissionImpl
+	pkgreconciler.LeaderAwareFuncs
+
+	key  types.NamespacedName
+	path string
+
+	withContext func(context.Context) context.Context
+
+	client              kubernetes.Interface
+	mwhlister           admissionlisters.MutatingWebhookConfigurationLister
+	secretlister        corelisters.SecretLister
+	tektonTriggerLister operatorlisters.TektonTriggerLister
+
+	secretName string
+}
+
+var _ controller.Reconciler = (*reconciler)(nil)
+var _ pkgreconciler.LeaderAware = (*reconciler)(nil)
+var _ webhook.AdmissionController = (*reconciler)(nil)
+var _ webhook.StatelessAdmissionController = (*reconciler)(nil)
+
+// Reconcile implements controller.Reconciler
+func (ac *reconciler) Reconcile(ctx context.Context, key string) error {
+	logger := logging.FromContext(ctx)
+
+	if !ac.IsLeaderFor(ac.key) {
+		logger.Debugf("Skipping key %q, not the leader.", ac.key)
+		return nil
+	}
+
+	// Look up the webhook secret, and fetch the CA cert bundle.
+	secret, err := ac.secretlister.Secrets(system.Namespace()).Get(ac.secretName)
+	if err != nil {
+		logger.Errorw("Error fetching secret", zap.Error(err))
+		return err
+	}
+	caCert, ok := secret.Data[certresources.CACert]
+	if !ok {
+		return fmt.Errorf("secret %q is missing %q key", ac.secretName, certresources.CACert)
+	}
+
+	// Reconcile the webhook configuration.
+	return ac.reconcileMutatingWebhook(ctx, caCert)
+}
+
+// Path implements AdmissionController
+func (ac *reconciler) Path() string {
+	return ac.path
+}
+
+// Admit implements AdmissionController
+func (ac *reconciler) Admit(ctx context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if ac.withContext != nil {
+		ctx = ac.withContext(ctx)
+	}
+
+	logger := logging.FromContext(ctx)
+	switch request.Operation {
+	case admissionv1.Create, admissionv1.Update:
+	default:
+		logger.Info("Unhandled webhook operation, letting it through ", request.Operation)
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	tls, err := ac.eventListenerTLS()
+	if err != nil {
+		return webhook.MakeErrorStatus("failed to get the TektonTrigger: %v", err)
+	}
+	if tls == nil {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	patchBytes, err := mutate(request.Object.Raw)
+	if err != nil {
+		return webhook.MakeErrorStatus("mutation failed: %v", err)
+	}
+	logger.Infof("Kind: %q PatchBytes: %v", request.Kind, string(patchBytes))
+
+	return &admissionv1.AdmissionResponse{
+		Patch:   patchBytes,
+		Allowed: true,
+		PatchType: func() *admissionv1.PatchType {
+			pt := admissionv1.PatchTypeJSONPatch
+			return &pt
+		}(),
+	}
+}
+
+// eventListenerTLS returns the eventListenerTLS of TektonTrigger, nil when TektonTrigger is not installed
+func (ac *reconciler) eventListenerTLS() (*v1alpha1.EventListenerTLS, error) {
+	tt, err := ac.tektonTriggerLister.Get(v1alpha1.TriggerResourceName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return tt.Spec.EventListenerTLS, nil
+}
+
+func (ac *reconciler) reconcileMutatingWebhook(ctx context.Context, caCert []byte) error {
+	logger := logging.FromContext(ctx)
+
+	tls, err := ac.eventListenerTLS()
+	if err != nil {
+		return err
+	}
+	// without eventListenerTLS the webhook has no rule, and is never called
+	var rules []admissionregistrationv1.RuleWithOperations
+	namespaceSelector := &metav1.LabelSelector{}
+	if tls != nil && tls.NamespaceSelector != nil {
+		rules = []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Create,
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{"triggers.tekton.dev"},
+					APIVersions: []string{"v1beta1"},
+					Resources:   []string{"eventlisteners"},
+				},
+			},
+		}
+		namespaceSelector = tls.NamespaceSelector.DeepCopy()
+	}
+	namespaceSelector.MatchExpressions = append(namespaceSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+		// "control-plane" is added to support Azure's AKS, otherwise the controllers fight.
+		// See knative/pkg#1590 for details.
+		Key:      "control-plane",
+		Operator: metav1.LabelSelectorOpDoesNotExist,
+	})
+
+	configuredWebhook, err := ac.mwhlister.Get(ac.key.Name)
+	if err != nil {
+		return fmt.Errorf("error retrieving webhook: %w", err)
+	}
+
+	webhook := configuredWebhook.DeepCopy()
+
+	// Clear out any previous (bad) OwnerReferences.
+	// See: https://github.com/knative/serving/issues/5845
+	webhook.OwnerReferences = nil
+
+	for i, wh := range webhook.Webhooks {
+		if wh.Name != webhook.Name {
+			continue
+		}
+		webhook.Webhooks[i].Rules = rules
+		webhook.Webhooks[i].NamespaceSelector = namespaceSelector
+		webhook.Webhooks[i].ClientConfig.CABundle = caCert
+		if webhook.Webhooks[i].ClientConfig.Service == nil {
+			return fmt.Errorf("missing service reference for webhook: %s", wh.Name)
+		}
+		webhook.Webhooks[i].ClientConfig.Service.Path = ptr.String(ac.Path())
+	}
+
+	if ok, err := kmp.SafeEqual(configuredWebhook, webhook); err != nil {
+		return fmt.Errorf("error diffing webhooks: %w", err)
+	} else if !ok {
+		logger.Info("Updating webhook")
+		mwhclient := ac.client.AdmissionregistrationV1().MutatingWebhookConfigurations()
+		if _, err := mwhclient.Update(ctx, webhook, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update webhook: %w", err)
+		}
+	} else {
+		logger.Info("Webhook is valid")
+	}
+	return nil
+}
+
+// mutate returns the patch setting the TLS env on the first container of the EventListener, the
+// certificate is issued in the referenced secret by the TektonTrigger reconciler
+func mutate(raw []byte) ([]byte, error) {
+	el := &unstructured.Unstructured{}
+	if err := json.Unmarshal(raw, &el.Object); err != nil {
+		return nil, fmt.Errorf("cannot decode incoming new object: %w", err)
+	}
+	// the pod of a custom resource is not known
+	if _, found, _ := unstructured.NestedFieldNoCopy(el.Object, "spec", "resources", "customResource"); found {
+		return json.Marshal([]jsonpatch.Operation{})
+	}
+
+	containersPath := []string{"spec", "resources", "kubernetesResource", "spec", "template", "spec", "containers"}
+	containers, _, err := unstructured.NestedSlice(el.Object, containersPath...)
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		containers = []interface{}{map[string]interface{}{}}
+	}
+	container, ok := containers[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid container in EventListener %s", el.GetName())
+	}
+
+	decoded := &corev1.Container{}
+	if rawEnv, found := container["env"]; found {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(map[string]interface{}{"env": rawEnv}, decoded); err != nil {
+			return nil, err
+		}
+	}
+	envObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&corev1.Container{
+		Env: common.MergeEventListenerTLSEnv(decoded.Env, el.GetName()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	container["env"] = envObj["env"]
+	containers[0] = container
+	if err := unstructured.SetNestedSlice(el.Object, containers, containersPath...); err != nil {
+		return nil, err
+	}
+
+	mutated, err := json.Marshal(el.Object)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.CreatePatch(raw, mutated)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(patch)
+}
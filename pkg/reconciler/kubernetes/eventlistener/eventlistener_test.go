@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventlistener
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	operatorinformers "github.com/tektoncd/operator/pkg/client/informers/externalversions"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"gotest.tools/v3/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func newTestReconciler(t *testing.T, tls *v1alpha1.EventListenerTLS) *reconciler {
+	t.Helper()
+	tt := &v1alpha1.TektonTrigger{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.TriggerResourceName},
+		Spec: v1alpha1.TektonTriggerSpec{
+			Trigger: v1alpha1.Trigger{EventListenerTLS: tls},
+		},
+	}
+	informer := operatorinformers.NewSharedInformerFactory(operatorfake.NewSimpleClientset(tt), 0).Operator().V1alpha1().TektonTriggers()
+	assert.NilError(t, informer.Informer().GetStore().Add(tt))
+	return &reconciler{tektonTriggerLister: informer.Lister()}
+}
+
+func admit(t *testing.T, r *reconciler, el *v1beta1.EventListener) (*admissionv1.AdmissionResponse, *v1beta1.EventListener) {
+	t.Helper()
+	raw, err := json.Marshal(el)
+	assert.NilError(t, err)
+	response := r.Admit(context.TODO(), &admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	})
+	if len(response.Patch) == 0 {
+		return response, el
+	}
+	patch, err := jsonpatch.DecodePatch(response.Patch)
+	assert.NilError(t, err)
+	patched, err := patch.Apply(raw)
+	assert.NilError(t, err)
+	result := &v1beta1.EventListener{}
+	assert.NilError(t, json.Unmarshal(patched, result))
+	return response, result
+}
+
+func TestAdmit(t *testing.T) {
+	tls := &v1alpha1.EventListenerTLS{NamespaceSelector: &metav1.LabelSelector{}}
+	userEnv := corev1.EnvVar{Name: "LOG_LEVEL", Value: "debug"}
+
+	tests := []struct {
+		name    string
+		tls     *v1alpha1.EventListenerTLS
+		el      *v1beta1.EventListener
+		wantEnv []corev1.EnvVar
+	}{{
+		name:    "eventListenerTLS not set",
+		el:      &v1beta1.EventListener{ObjectMeta: metav1.ObjectMeta{Name: "github"}},
+		wantEnv: nil,
+	}, {
+		name:    "without kubernetesResource",
+		tls:     tls,
+		el:      &v1beta1.EventListener{ObjectMeta: metav1.ObjectMeta{Name: "github"}},
+		wantEnv: common.EventListenerTLSEnv("github"),
+	}, {
+		name: "with the env of the user",
+		tls:  tls,
+		el: &v1beta1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "github"},
+			Spec: v1beta1.EventListenerSpec{Resources: v1beta1.Resources{KubernetesResource: &v1beta1.KubernetesResource{
+				WithPodSpec: withEnv(userEnv),
+			}}},
+		},
+		wantEnv: append([]corev1.EnvVar{userEnv}, common.EventListenerTLSEnv("github")...),
+	}, {
+		name: "already set",
+		tls:  tls,
+		el: &v1beta1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "github"},
+			Spec: v1beta1.EventListenerSpec{Resources: v1beta1.Resources{KubernetesResource: &v1beta1.KubernetesResource{
+				WithPodSpec: withEnv(common.EventListenerTLSEnv("github")...),
+			}}},
+		},
+		wantEnv: common.EventListenerTLSEnv("github"),
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, el := admit(t, newTestReconciler(t, test.tls), test.el)
+			assert.Assert(t, response.Allowed)
+			var env []corev1.EnvVar
+			if el.Spec.Resources.KubernetesResource != nil {
+				env = el.Spec.Resources.KubernetesResource.Template.Spec.Containers[0].Env
+			}
+			assert.DeepEqual(t, env, test.wantEnv)
+		})
+	}
+}
+
+func TestAdmit_CustomResource(t *testing.T) {
+	r := newTestReconciler(t, &v1alpha1.EventListenerTLS{NamespaceSelector: &metav1.LabelSelector{}})
+	el := &v1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "github"},
+		Spec: v1beta1.EventListenerSpec{Resources: v1beta1.Resources{CustomResource: &v1beta1.CustomResource{
+			RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"serving.knative.dev/v1","kind":"Service"}`)},
+		}}},
+	}
+	response, patched := admit(t, r, el)
+	assert.Assert(t, response.Allowed)
+	assert.Assert(t, patched.Spec.Resources.KubernetesResource == nil)
+}
+
+func withEnv(env ...corev1.EnvVar) duckv1.WithPodSpec {
+	return duckv1.WithPodSpec{Template: duckv1.PodSpecable{Spec: corev1.PodSpec{
+		Containers: []corev1.Container{{Env: env}},
+	}}}
+}
+
+func TestReconcileMutatingWebhook(t *testing.T) {
+	name := "eventlistener.operator.tekton.dev"
+	configuration := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{{
+			Name: name,
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{Name: "tekton-operator-proxy-webhook"},
+			},
+		}},
+	}
+
+	tests := []struct {
+		name          string
+		tls           *v1alpha1.EventListenerTLS
+		wantRules     bool
+		wantSelectors map[string]string
+	}{{
+		name: "eventListenerTLS not set",
+	}, {
+		name:          "eventListenerTLS set",
+		tls:           &v1alpha1.EventListenerTLS{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ci"}}},
+		wantRules:     true,
+		wantSelectors: map[string]string{"team": "ci"},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := k8sfake.NewSimpleClientset(configuration)
+			mwhInformer := informers.NewSharedInformerFactory(kubeClient, 0).Admissionregistration().V1().MutatingWebhookConfigurations()
+			assert.NilError(t, mwhInformer.Informer().GetStore().Add(configuration))
+
+			r := newTestReconciler(t, test.tls)
+			r.key = types.NamespacedName{Name: name}
+			r.path = "/eventlistener-defaulting"
+			r.client = kubeClient
+			r.mwhlister = mwhInformer.Lister()
+
+			assert.NilError(t, r.reconcileMutatingWebhook(context.TODO(), []byte("ca")))
+
+			updated, err := kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(context.TODO(), name, metav1.GetOptions{})
+			assert.NilError(t, err)
+			wh := updated.Webhooks[0]
+			assert.Equal(t, len(wh.Rules) > 0, test.wantRules)
+			assert.DeepEqual(t, wh.NamespaceSelector.MatchLabels, test.wantSelectors)
+			assert.Equal(t, *wh.ClientConfig.Service.Path, "/eventlistener-defaulting")
+			assert.DeepEqual(t, wh.ClientConfig.CABundle, []byte("ca"))
+		})
+	}
+}
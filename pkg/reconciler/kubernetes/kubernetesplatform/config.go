@@ -18,6 +18,7 @@ package kubernetesplatform
 
 import (
 	k8sManualApprovalGate "github.com/tektoncd/operator/pkg/reconciler/kubernetes/manualapprovalgate"
+	k8sAddon "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonaddon"
 	k8sChain "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonchain"
 	k8sConfig "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonconfig"
 	k8sDashboard "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektondashboard"
@@ -65,6 +66,9 @@ var (
 		platform.ControllerTektonPruner: injection.NamedControllerConstructor{
 			Name:                  string(platform.ControllerTektonPruner),
 			ControllerConstructor: k8sTektonPruner.NewController},
+		platform.ControllerTektonAddon: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerTektonAddon),
+			ControllerConstructor: k8sAddon.NewController},
 		platform.ControllerTektonInstallerSet: injection.NamedControllerConstructor{
 			Name:                  string(platform.ControllerTektonInstallerSet),
 			ControllerConstructor: k8sInstallerSet.NewController},
@@ -124,6 +124,12 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, mag *v1alpha1.ManualAppr
 	logger.Info("Pre-reconciliation completed successfully")
 	mag.Status.MarkPreReconcilerComplete()
 
+	// Ensure webhook deadlock prevention before applying the manifest
+	if err := common.PreemptDeadlock(ctx, &r.manifest, r.kubeClientSet, mag); err != nil {
+		logger.Errorw("Failed to preempt webhook deadlock", "error", err)
+		return err
+	}
+
 	if err := r.installerSetClient.MainSet(ctx, mag, &r.manifest, filterAndTransform(r.extension)); err != nil {
 		msg := fmt.Sprintf("Main Reconcilation failed: %s", err.Error())
 		logger.Errorw("Failed to apply main installer set", "error", err)
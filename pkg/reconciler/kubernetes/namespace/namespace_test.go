@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespace
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	operatorinformers "github.com/tektoncd/operator/pkg/client/informers/externalversions"
+	"gotest.tools/v3/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/logging"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func namespaceRaw(t *testing.T, level string) runtime.RawExtension {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+	if level != "" {
+		namespace.Labels = map[string]string{v1alpha1.PodSecurityEnforceLabel: level}
+	}
+	raw, err := json.Marshal(namespace)
+	assert.NilError(t, err)
+	return runtime.RawExtension{Raw: raw}
+}
+
+func TestReconciler_Admit_PodSecurityWithoutTektonConfig(t *testing.T) {
+	tektonConfigInformer := operatorinformers.NewSharedInformerFactory(operatorfake.NewSimpleClientset(), 0).Operator().V1alpha1().TektonConfigs()
+	r := &reconciler{tektonConfigLister: tektonConfigInformer.Lister()}
+
+	ctx := logging.WithLogger(context.Background(), logtesting.TestLogger(t))
+	response := r.Admit(ctx, &admissionv1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "Namespace"},
+		Operation: admissionv1.Create,
+		Object:    namespaceRaw(t, v1alpha1.PodSecurityPrivileged),
+	})
+	assert.Assert(t, response.Allowed)
+}
+
+func TestReconciler_Admit_PodSecurity(t *testing.T) {
+	tektonConfig := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			Platforms: v1alpha1.Platforms{
+				Kubernetes: v1alpha1.Kubernetes{
+					PodSecurity: &v1alpha1.PodSecurity{
+						Default:    v1alpha1.PodSecurityRestricted,
+						MaxAllowed: v1alpha1.PodSecurityBaseline,
+					},
+				},
+			},
+		},
+	}
+	operatorClient := operatorfake.NewSimpleClientset(tektonConfig)
+	tektonConfigInformer := operatorinformers.NewSharedInformerFactory(operatorClient, 0).Operator().V1alpha1().TektonConfigs()
+	assert.NilError(t, tektonConfigInformer.Informer().GetStore().Add(tektonConfig))
+	r := &reconciler{tektonConfigLister: tektonConfigInformer.Lister()}
+
+	tests := []struct {
+		name        string
+		operation   admissionv1.Operation
+		level       string
+		oldLevel    string
+		wantAllowed bool
+	}{
+		{name: "create without level", operation: admissionv1.Create, wantAllowed: true},
+		{name: "create with allowed level", operation: admissionv1.Create, level: v1alpha1.PodSecurityBaseline, wantAllowed: true},
+		{name: "create above maxAllowed", operation: admissionv1.Create, level: v1alpha1.PodSecurityPrivileged, wantAllowed: false},
+		{name: "update to allowed level", operation: admissionv1.Update, level: v1alpha1.PodSecurityRestricted, oldLevel: v1alpha1.PodSecurityBaseline, wantAllowed: true},
+		{name: "update above maxAllowed", operation: admissionv1.Update, level: v1alpha1.PodSecurityPrivileged, oldLevel: v1alpha1.PodSecurityRestricted, wantAllowed: false},
+		{name: "update keeping the level", operation: admissionv1.Update, level: v1alpha1.PodSecurityPrivileged, oldLevel: v1alpha1.PodSecurityPrivileged, wantAllowed: true},
+		{name: "delete", operation: admissionv1.Delete, level: v1alpha1.PodSecurityPrivileged, wantAllowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &admissionv1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "Namespace"},
+				Operation: tt.operation,
+				Object:    namespaceRaw(t, tt.level),
+			}
+			if tt.operation == admissionv1.Update {
+				req.OldObject = namespaceRaw(t, tt.oldLevel)
+			}
+
+			ctx := logging.WithLogger(context.Background(), logtesting.TestLogger(t))
+			response := r.Admit(ctx, req)
+			assert.Equal(t, tt.wantAllowed, response.Allowed)
+			if !tt.wantAllowed {
+				assert.Equal(t, response.Result.Message, "namespace: test-namespace has requested pod security level: privileged, but it is less restrictive than 'maxAllowed' level: baseline")
+			}
+		})
+	}
+}
+
+func TestReconciler_Admit_PodSecurityRemoved(t *testing.T) {
+	tektonConfig := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			Platforms: v1alpha1.Platforms{
+				Kubernetes: v1alpha1.Kubernetes{
+					PodSecurity: &v1alpha1.PodSecurity{MaxAllowed: v1alpha1.PodSecurityBaseline},
+				},
+			},
+		},
+	}
+	tektonConfigInformer := operatorinformers.NewSharedInformerFactory(operatorfake.NewSimpleClientset(tektonConfig), 0).Operator().V1alpha1().TektonConfigs()
+	assert.NilError(t, tektonConfigInformer.Informer().GetStore().Add(tektonConfig))
+	r := &reconciler{tektonConfigLister: tektonConfigInformer.Lister()}
+	ctx := logging.WithLogger(context.Background(), logtesting.TestLogger(t))
+
+	admit := func(oldNamespace *corev1.Namespace) *admissionv1.AdmissionResponse {
+		raw, err := json.Marshal(oldNamespace)
+		assert.NilError(t, err)
+		return r.Admit(ctx, &admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "Namespace"},
+			Operation: admissionv1.Update,
+			Object:    namespaceRaw(t, ""),
+			OldObject: runtime.RawExtension{Raw: raw},
+		})
+	}
+
+	// the users can not remove the level, the namespace would take the level of the cluster
+	labelled := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "test-namespace",
+		Labels: map[string]string{v1alpha1.PodSecurityEnforceLabel: v1alpha1.PodSecurityRestricted},
+	}}
+	response := admit(labelled)
+	assert.Assert(t, !response.Allowed)
+	assert.Equal(t, response.Result.Message, "namespace: test-namespace can not remove its pod security level: restricted, the default level of the cluster may be less restrictive than 'maxAllowed' level: baseline")
+
+	// the operator removes the levels it labelled
+	labelled.Annotations = map[string]string{v1alpha1.PodSecurityDefaultAnnotation: v1alpha1.PodSecurityRestricted}
+	assert.Assert(t, admit(labelled).Allowed)
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonaddon
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// maxCatalogFileSize limits the size of a single file fetched for a catalog
+	maxCatalogFileSize  = 10 * 1024 * 1024
+	catalogFetchTimeout = 30 * time.Second
+
+	// annotation on the layers of a Tekton bundle with the kind of the resource
+	bundleKindAnnotation = "dev.tekton.image.kind"
+
+	catalogVersionLabel = "app.kubernetes.io/version"
+)
+
+// fetchCatalog fetches the Tasks and StepActions of a catalog from its source and applies the filters
+func fetchCatalog(ctx context.Context, kc kubernetes.Interface, catalog v1alpha1.AddonCatalog, targetNamespace string) (mf.Manifest, error) {
+	var (
+		manifest mf.Manifest
+		err      error
+	)
+	switch {
+	case catalog.Git != nil:
+		manifest, err = fetchGitCatalog(ctx, catalog.Git)
+	case catalog.Bundle != nil:
+		manifest, err = fetchBundleCatalog(ctx, kc, catalog.Bundle)
+	case catalog.ConfigMap != nil:
+		manifest, err = fetchConfigMapCatalog(ctx, kc, catalog.ConfigMap, targetNamespace)
+	case catalog.URL != nil:
+		manifest, err = fetchURLCatalog(ctx, catalog.URL)
+	default:
+		return mf.Manifest{}, fmt.Errorf("catalog %s has no source", catalog.Name)
+	}
+	if err != nil {
+		return mf.Manifest{}, fmt.Errorf("failed to fetch catalog %s: %w", catalog.Name, err)
+	}
+	return manifest.Filter(mf.Any(mf.ByKind(KindTask), mf.ByKind(KindStepAction)), catalogFilter(catalog.Filters)), nil
+}
+
+// catalogFilter selects the resources matching any of the filters, all the resources
+// are selected if there are no filters
+func catalogFilter(filters []v1alpha1.CatalogFilter) mf.Predicate {
+	return func(u *unstructured.Unstructured) bool {
+		if len(filters) == 0 {
+			return true
+		}
+		for _, filter := range filters {
+			if globMatch(filter.Name, u.GetName()) && globMatch(filter.Version, u.GetLabels()[catalogVersionLabel]) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func globMatch(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
+
+func fetchGitCatalog(ctx context.Context, src *v1alpha1.GitCatalogSource) (mf.Manifest, error) {
+	manifest := mf.Manifest{}
+	for _, filePath := range src.Paths {
+		rawURL, err := gitRawURL(src, filePath)
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		data, err := fetchURL(ctx, rawURL)
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		m, err := manifestFromBytes(data)
+		if err != nil {
+			return mf.Manifest{}, fmt.Errorf("failed to read %s: %w", filePath, err)
+		}
+		manifest = manifest.Append(m)
+	}
+	return manifest, nil
+}
+
+// gitRawURL returns the url serving the raw content of a file of a repository, repositories on
+// github.com are served by raw.githubusercontent.com, the other GitHub hosts redirect to their raw host
+func gitRawURL(src *v1alpha1.GitCatalogSource, filePath string) (string, error) {
+	u, err := url.Parse(src.URL)
+	if err != nil {
+		return "", fmt.Errorf("invalid git url %s: %w", src.URL, err)
+	}
+	revision := src.Revision
+	if revision == "" {
+		revision = "HEAD"
+	}
+	repoPath := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	filePath = strings.TrimPrefix(filePath, "/")
+
+	switch src.GetProvider() {
+	case v1alpha1.GitProviderGitHub:
+		if u.Host == "github.com" {
+			return fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s", repoPath, revision, filePath), nil
+		}
+		return fmt.Sprintf("%s://%s/%s/raw/%s/%s", u.Scheme, u.Host, repoPath, revision, filePath), nil
+	case v1alpha1.GitProviderGitLab:
+		return fmt.Sprintf("%s://%s/%s/-/raw/%s/%s", u.Scheme, u.Host, repoPath, revision, filePath), nil
+	}
+	return "", fmt.Errorf("provider of the git url %s is not supported", src.URL)
+}
+
+func fetchURLCatalog(ctx context.Context, src *v1alpha1.URLCatalogSource) (mf.Manifest, error) {
+	data, err := fetchURL(ctx, src.URL)
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	checksum := fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+	if checksum != src.Checksum {
+		return mf.Manifest{}, fmt.Errorf("checksum mismatch for %s, expected %s, got %s", src.URL, src.Checksum, checksum)
+	}
+	return manifestFromBytes(data)
+}
+
+func fetchConfigMapCatalog(ctx context.Context, kc kubernetes.Interface, src *v1alpha1.ConfigMapCatalogSource, targetNamespace string) (mf.Manifest, error) {
+	namespace := src.Namespace
+	if namespace == "" {
+		namespace = targetNamespace
+	}
+	cm, err := kc.CoreV1().ConfigMaps(namespace).Get(ctx, src.Name, metav1.GetOptions{})
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+
+	keys := make([]string, 0, len(cm.Data))
+	for key := range cm.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	manifest := mf.Manifest{}
+	for _, key := range keys {
+		m, err := manifestFromBytes([]byte(cm.Data[key]))
+		if err != nil {
+			return mf.Manifest{}, fmt.Errorf("failed to read key %s of configmap %s/%s: %w", key, namespace, src.Name, err)
+		}
+		manifest = manifest.Append(m)
+	}
+	return manifest, nil
+}
+
+// fetchBundleCatalog reads the Tasks and StepActions of a Tekton bundle, each layer
+// of a bundle is a tarball holding a single resource
+func fetchBundleCatalog(ctx context.Context, kc kubernetes.Interface, src *v1alpha1.BundleCatalogSource) (mf.Manifest, error) {
+	ref, err := name.ParseReference(src.Image)
+	if err != nil {
+		return mf.Manifest{}, fmt.Errorf("invalid bundle reference %s: %w", src.Image, err)
+	}
+	keychain, err := serviceAccountKeychain(ctx, kc)
+	if err != nil {
+		return mf.Manifest{}, err
+	}
+	img, err := remote.Image(ref, remote.WithContext(ctx), remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return mf.Manifest{}, fmt.Errorf("failed to pull bundle %s: %w", src.Image, err)
+	}
+	imgManifest, err := img.Manifest()
+	if err != nil {
+		return mf.Manifest{}, fmt.Errorf("failed to read manifest of bundle %s: %w", src.Image, err)
+	}
+
+	manifest := mf.Manifest{}
+	for _, desc := range imgManifest.Layers {
+		switch strings.ToLower(desc.Annotations[bundleKindAnnotation]) {
+		case "task", "stepaction":
+		default:
+			continue
+		}
+		layer, err := img.LayerByDigest(desc.Digest)
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		data, err := readBundleLayer(layer.Uncompressed)
+		if err != nil {
+			return mf.Manifest{}, fmt.Errorf("failed to read layer %s of bundle %s: %w", desc.Digest, src.Image, err)
+		}
+		m, err := manifestFromBytes(data)
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		manifest = manifest.Append(m)
+	}
+	return manifest, nil
+}
+
+func readBundleLayer(open func() (io.ReadCloser, error)) ([]byte, error) {
+	rc, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	tr := tar.NewReader(rc)
+	if _, err := tr.Next(); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(io.LimitReader(tr, maxCatalogFileSize))
+}
+
+func fetchURL(ctx context.Context, rawURL string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, catalogFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", rawURL, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxCatalogFileSize))
+}
+
+func manifestFromBytes(data []byte) (mf.Manifest, error) {
+	return mf.ManifestFrom(mf.Reader(bytes.NewReader(data)))
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonaddon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// labels on the installer set of a catalog, with the name and the digest of its content
+	catalogNameLabel   = "operator.tekton.dev/catalog"
+	catalogDigestLabel = "operator.tekton.dev/catalog-digest"
+
+	catalogInstallerSetPrefix = "Catalog-"
+	providerTypeCustom        = "custom"
+)
+
+// catalogCache keeps the fetched content of the catalogs, so that the sources are
+// fetched again only when the catalog changes or the refresh interval is elapsed
+type catalogCache struct {
+	mutex   sync.Mutex
+	entries map[string]catalogEntry
+}
+
+type catalogEntry struct {
+	specHash  string
+	fetchedAt time.Time
+	manifest  mf.Manifest
+	digest    string
+}
+
+type catalogFetcher func(ctx context.Context, catalog v1alpha1.AddonCatalog, targetNamespace string) (mf.Manifest, error)
+
+func newCatalogCache() *catalogCache {
+	return &catalogCache{entries: map[string]catalogEntry{}}
+}
+
+// get returns the content of the catalog and its digest, fetching it if required
+func (c *catalogCache) get(ctx context.Context, catalog v1alpha1.AddonCatalog, targetNamespace string, fetch catalogFetcher) (mf.Manifest, string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	specHash, err := hash.Compute(struct {
+		Catalog         v1alpha1.AddonCatalog
+		TargetNamespace string
+	}{catalog, targetNamespace})
+	if err != nil {
+		return mf.Manifest{}, "", err
+	}
+
+	entry, found := c.entries[catalog.Name]
+	if found && entry.specHash == specHash &&
+		(catalog.RefreshInterval == nil || time.Since(entry.fetchedAt) < catalog.RefreshInterval.Duration) {
+		return entry.manifest, entry.digest, nil
+	}
+
+	manifest, err := fetch(ctx, catalog, targetNamespace)
+	if err != nil {
+		return mf.Manifest{}, "", err
+	}
+	digest, err := hash.Compute(manifest.Resources())
+	if err != nil {
+		return mf.Manifest{}, "", err
+	}
+	c.entries[catalog.Name] = catalogEntry{
+		specHash:  specHash,
+		fetchedAt: time.Now(),
+		manifest:  manifest,
+		// label values are limited to 63 characters
+		digest: digest[:16],
+	}
+	return manifest, digest[:16], nil
+}
+
+// EnsureCatalogs installs the user defined catalogs, each with its own installer set, and
+// removes the installer sets of the catalogs no longer listed. It returns the shortest
+// refresh interval of the catalogs, zero if none of them has to be refreshed
+func (r *Reconciler) EnsureCatalogs(ctx context.Context, ta *v1alpha1.TektonAddon) (time.Duration, error) {
+	if err := r.cleanupRemovedCatalogs(ctx, ta.Spec.Catalogs); err != nil {
+		return 0, err
+	}
+
+	var refreshInterval time.Duration
+	for _, catalog := range ta.Spec.Catalogs {
+		if catalog.RefreshInterval != nil && (refreshInterval == 0 || catalog.RefreshInterval.Duration < refreshInterval) {
+			refreshInterval = catalog.RefreshInterval.Duration
+		}
+		if err := r.ensureCatalog(ctx, ta, catalog); err != nil {
+			return refreshInterval, err
+		}
+	}
+	return refreshInterval, nil
+}
+
+func (r *Reconciler) ensureCatalog(ctx context.Context, ta *v1alpha1.TektonAddon, catalog v1alpha1.AddonCatalog) error {
+	manifest, digest, err := r.catalogCache.get(ctx, catalog, ta.Spec.GetTargetNamespace(), r.fetchCatalog)
+	if err != nil {
+		return err
+	}
+
+	customLabels := map[string]string{
+		catalogNameLabel:   catalog.Name,
+		catalogDigestLabel: digest,
+	}
+	transformers := r.filterAndTransformResolverTask(catalogTransformers(ctx, catalog.Name))
+
+	// the installer set is updated only on spec changes, update it in place when the
+	// content of the catalog changed on a refresh, its tasks stay available meanwhile
+	sets, err := r.installerSetClient.ListCustomSet(ctx, catalogSelector(selection.Equals, catalog.Name))
+	if err != nil {
+		return err
+	}
+	for _, set := range sets.Items {
+		if set.GetLabels()[catalogDigestLabel] == digest || set.DeletionTimestamp != nil {
+			continue
+		}
+		logging.FromContext(ctx).Infof("content of catalog %s changed, updating its installer set", catalog.Name)
+		removed, err := r.installerSetClient.UpdateCustomSet(ctx, ta, set, &manifest, transformers, customLabels)
+		if err != nil {
+			return err
+		}
+		// the resources dropped from the catalog are not removed by the installer set
+		if len(removed) == 0 {
+			continue
+		}
+		removedManifest, err := mf.ManifestFrom(mf.Slice(removed), mf.UseClient(r.manifest.Client))
+		if err != nil {
+			return err
+		}
+		if err := removedManifest.Delete(); err != nil {
+			return fmt.Errorf("failed to remove the resources dropped from catalog %s: %w", catalog.Name, err)
+		}
+	}
+
+	return r.installerSetClient.CustomSet(ctx, ta, catalogInstallerSetPrefix+catalog.Name, &manifest, transformers, customLabels)
+}
+
+func (r *Reconciler) cleanupRemovedCatalogs(ctx context.Context, catalogs []v1alpha1.AddonCatalog) error {
+	sets, err := r.installerSetClient.ListCustomSet(ctx, catalogSelector(selection.Exists))
+	if err != nil {
+		return err
+	}
+	for _, set := range sets.Items {
+		catalogName := set.GetLabels()[catalogNameLabel]
+		if catalogListed(catalogs, catalogName) {
+			continue
+		}
+		if err := r.installerSetClient.CleanupCustomSet(ctx, catalogInstallerSetPrefix+catalogName); err != nil {
+			return fmt.Errorf("failed to remove catalog %s: %w", catalogName, err)
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) fetchCatalog(ctx context.Context, catalog v1alpha1.AddonCatalog, targetNamespace string) (mf.Manifest, error) {
+	return fetchCatalog(ctx, r.kubeClientSet, catalog, targetNamespace)
+}
+
+func catalogTransformers(ctx context.Context, catalogName string) []mf.Transformer {
+	imagesRaw := common.ToLowerCaseKeys(common.ImagesFromEnv(common.AddonsImagePrefix))
+	addonImages := common.ImageRegistryDomainOverride(imagesRaw)
+	return []mf.Transformer{
+		injectLabel(labelProviderType, providerTypeCustom, overwrite, KindTask, KindStepAction),
+		injectLabel(catalogNameLabel, catalogName, overwrite, KindTask, KindStepAction),
+		common.TaskImages(ctx, addonImages),
+		stepActionImages(ctx, addonImages),
+	}
+}
+
+// stepActionImages applies common.StepActionImages on StepActions only
+func stepActionImages(ctx context.Context, images map[string]string) mf.Transformer {
+	transformer := common.StepActionImages(ctx, images)
+	return func(u *unstructured.Unstructured) error {
+		if u.GetKind() != KindStepAction {
+			return nil
+		}
+		return transformer(u)
+	}
+}
+
+func catalogSelector(op selection.Operator, values ...string) string {
+	labelSelector := labels.NewSelector()
+	createdReq, _ := labels.NewRequirement(v1alpha1.CreatedByKey, selection.Equals, []string{v1alpha1.KindTektonAddon})
+	if createdReq != nil {
+		labelSelector = labelSelector.Add(*createdReq)
+	}
+	catalogReq, _ := labels.NewRequirement(catalogNameLabel, op, values)
+	if catalogReq != nil {
+		labelSelector = labelSelector.Add(*catalogReq)
+	}
+	return labelSelector.String()
+}
+
+func catalogListed(catalogs []v1alpha1.AddonCatalog, name string) bool {
+	for _, catalog := range catalogs {
+		if catalog.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonaddon
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	isfake "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+const catalogTasks = `apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: git-clone
+  labels:
+    app.kubernetes.io/version: "0.9"
+spec:
+  steps:
+  - name: clone
+    image: alpine
+---
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: buildah
+  labels:
+    app.kubernetes.io/version: "0.7"
+spec:
+  steps:
+  - name: build
+    image: buildah
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: not-a-task
+`
+
+func TestGitRawURL(t *testing.T) {
+	tests := []struct {
+		src  v1alpha1.GitCatalogSource
+		want string
+	}{
+		{
+			src:  v1alpha1.GitCatalogSource{URL: "https://github.com/tektoncd/catalog", Revision: "main"},
+			want: "https://raw.githubusercontent.com/tektoncd/catalog/main/task/git-clone/0.9/git-clone.yaml",
+		},
+		{
+			src:  v1alpha1.GitCatalogSource{URL: "https://github.com/tektoncd/catalog.git"},
+			want: "https://raw.githubusercontent.com/tektoncd/catalog/HEAD/task/git-clone/0.9/git-clone.yaml",
+		},
+		{
+			src:  v1alpha1.GitCatalogSource{URL: "https://github.example.com/tekton/catalog", Provider: v1alpha1.GitProviderGitHub, Revision: "main"},
+			want: "https://github.example.com/tekton/catalog/raw/main/task/git-clone/0.9/git-clone.yaml",
+		},
+		{
+			src:  v1alpha1.GitCatalogSource{URL: "https://gitlab.com/group/catalog", Revision: "main"},
+			want: "https://gitlab.com/group/catalog/-/raw/main/task/git-clone/0.9/git-clone.yaml",
+		},
+		{
+			src:  v1alpha1.GitCatalogSource{URL: "https://gitlab.example.com/group/catalog/", Provider: v1alpha1.GitProviderGitLab, Revision: "v1.0.0"},
+			want: "https://gitlab.example.com/group/catalog/-/raw/v1.0.0/task/git-clone/0.9/git-clone.yaml",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.src.URL, func(t *testing.T) {
+			got, err := gitRawURL(&test.src, "/task/git-clone/0.9/git-clone.yaml")
+			assert.NilError(t, err)
+			assert.Equal(t, got, test.want)
+		})
+	}
+
+	// the raw url of the other hosts is not known
+	_, err := gitRawURL(&v1alpha1.GitCatalogSource{URL: "https://gitea.example.com/team/catalog"}, "tasks.yaml")
+	assert.ErrorContains(t, err, "not supported")
+}
+
+func TestFetchCatalog_URL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, catalogTasks)
+	}))
+	defer server.Close()
+
+	catalog := v1alpha1.AddonCatalog{
+		Name: "tasks",
+		URL: &v1alpha1.URLCatalogSource{
+			URL:      server.URL,
+			Checksum: fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(catalogTasks))),
+		},
+	}
+	manifest, err := fetchCatalog(context.TODO(), nil, catalog, "tekton-pipelines")
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Resources()), 2)
+
+	catalog.URL.Checksum = fmt.Sprintf("sha256:%x", sha256.Sum256([]byte("other")))
+	_, err = fetchCatalog(context.TODO(), nil, catalog, "tekton-pipelines")
+	assert.ErrorContains(t, err, "checksum mismatch")
+}
+
+func TestFetchCatalog_ConfigMapWithFilters(t *testing.T) {
+	kc := k8sfake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "catalog", Namespace: "tekton-pipelines"},
+		Data:       map[string]string{"tasks.yaml": catalogTasks},
+	})
+
+	tests := []struct {
+		name    string
+		filters []v1alpha1.CatalogFilter
+		want    []string
+	}{
+		{
+			name: "no filters",
+			want: []string{"git-clone", "buildah"},
+		},
+		{
+			name:    "name pattern",
+			filters: []v1alpha1.CatalogFilter{{Name: "git-*"}},
+			want:    []string{"git-clone"},
+		},
+		{
+			name:    "version",
+			filters: []v1alpha1.CatalogFilter{{Version: "0.7"}},
+			want:    []string{"buildah"},
+		},
+		{
+			name:    "name and version not matching",
+			filters: []v1alpha1.CatalogFilter{{Name: "git-clone", Version: "0.7"}},
+			want:    []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			catalog := v1alpha1.AddonCatalog{
+				Name:      "tasks",
+				ConfigMap: &v1alpha1.ConfigMapCatalogSource{Name: "catalog"},
+				Filters:   test.filters,
+			}
+			manifest, err := fetchCatalog(context.TODO(), kc, catalog, "tekton-pipelines")
+			assert.NilError(t, err)
+			names := []string{}
+			for _, u := range manifest.Resources() {
+				names = append(names, u.GetName())
+			}
+			assert.DeepEqual(t, names, test.want)
+		})
+	}
+}
+
+func TestCatalogCache(t *testing.T) {
+	fetched := 0
+	fetch := func(ctx context.Context, catalog v1alpha1.AddonCatalog, targetNamespace string) (mf.Manifest, error) {
+		fetched++
+		return mf.ManifestFrom(mf.Slice{})
+	}
+	cache := newCatalogCache()
+	catalog := v1alpha1.AddonCatalog{
+		Name:      "tasks",
+		ConfigMap: &v1alpha1.ConfigMapCatalogSource{Name: "catalog"},
+	}
+
+	_, digest, err := cache.get(context.TODO(), catalog, "tekton-pipelines", fetch)
+	assert.NilError(t, err)
+	assert.Equal(t, len(digest), 16)
+	_, _, err = cache.get(context.TODO(), catalog, "tekton-pipelines", fetch)
+	assert.NilError(t, err)
+	assert.Equal(t, fetched, 1)
+
+	// catalog changed
+	catalog.ConfigMap.Name = "other-catalog"
+	_, _, err = cache.get(context.TODO(), catalog, "tekton-pipelines", fetch)
+	assert.NilError(t, err)
+	assert.Equal(t, fetched, 2)
+
+	// refresh interval elapsed
+	catalog.RefreshInterval = &metav1.Duration{Duration: time.Hour}
+	_, _, err = cache.get(context.TODO(), catalog, "tekton-pipelines", fetch)
+	assert.NilError(t, err)
+	assert.Equal(t, fetched, 3)
+	entry := cache.entries[catalog.Name]
+	entry.fetchedAt = time.Now().Add(-2 * time.Hour)
+	cache.entries[catalog.Name] = entry
+	_, _, err = cache.get(context.TODO(), catalog, "tekton-pipelines", fetch)
+	assert.NilError(t, err)
+	assert.Equal(t, fetched, 4)
+}
+
+func TestEnsureCommunityResolverTask_NotFetched(t *testing.T) {
+	ctx := context.TODO()
+	r := &Reconciler{
+		installerSetClient: client.NewInstallerSetClient(isfake.NewFakeISClient(), "devel", "addon", v1alpha1.KindTektonAddon, nil),
+	}
+	ta := &v1alpha1.TektonAddon{}
+
+	// disabled, nothing is fetched
+	assert.NilError(t, r.EnsureCommunityResolverTask(ctx, "false", ta))
+	assert.Assert(t, r.communityResolverTaskManifest == nil)
+
+	// the user defined catalogs replace the community tasks
+	ta.Spec.Catalogs = []v1alpha1.AddonCatalog{{Name: "catalog", URL: &v1alpha1.URLCatalogSource{URL: "https://example.com/tasks.yaml"}}}
+	assert.NilError(t, r.EnsureCommunityResolverTask(ctx, "true", ta))
+	assert.Assert(t, r.communityResolverTaskManifest == nil)
+}
+
+func TestServiceAccountKeychain(t *testing.T) {
+	t.Setenv(operatorPodNameEnv, "tekton-operator-0")
+	t.Setenv("SYSTEM_NAMESPACE", "tekton-operator")
+	kc := k8sfake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "tekton-operator-0", Namespace: "tekton-operator"},
+			Spec:       corev1.PodSpec{ServiceAccountName: "tekton-operator"},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta:       metav1.ObjectMeta{Name: "tekton-operator", Namespace: "tekton-operator"},
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "missing"}, {Name: "registry"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: "tekton-operator"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"https://registry.example.com/v1/":{"username":"tekton","password":"secret"}}}`),
+			},
+		},
+	)
+
+	keychain, err := serviceAccountKeychain(context.TODO(), kc)
+	assert.NilError(t, err)
+
+	auth, err := keychain.Resolve(name.MustParseReference("registry.example.com/catalog:v1").Context())
+	assert.NilError(t, err)
+	cfg, err := auth.Authorization()
+	assert.NilError(t, err)
+	assert.Equal(t, cfg.Username, "tekton")
+	assert.Equal(t, cfg.Password, "secret")
+
+	auth, err = keychain.Resolve(name.MustParseReference("quay.io/catalog:v1").Context())
+	assert.NilError(t, err)
+	assert.Equal(t, auth, authn.Anonymous)
+}
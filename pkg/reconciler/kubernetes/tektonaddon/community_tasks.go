@@ -24,6 +24,7 @@ import (
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	"knative.dev/pkg/logging"
 )
 
 var communityResourceURLs = []string{
@@ -36,21 +37,27 @@ var communityResourceURLs = []string{
 	"https://raw.githubusercontent.com/tektoncd/catalog/main/task/argocd-task-sync-and-wait/0.2/argocd-task-sync-and-wait.yaml",
 }
 
+// EnsureCommunityResolverTask installs the community tasks, they are fetched from GitHub on the
+// first reconcile which needs them, the user defined catalogs replace them
 func (r *Reconciler) EnsureCommunityResolverTask(ctx context.Context, enable string, ta *v1alpha1.TektonAddon) error {
+	if enable != "true" || len(ta.Spec.Catalogs) > 0 {
+		return r.installerSetClient.CleanupCustomSet(ctx, CommunityResolverTaskInstallerSet)
+	}
+
+	if r.communityResolverTaskManifest == nil {
+		communityResolverTaskManifest := &mf.Manifest{}
+		if err := fetchCommunityResolverTasks(communityResolverTaskManifest); err != nil {
+			// if unable to fetch community task, don't fail
+			logging.FromContext(ctx).Errorf("failed to read community resolver task: %v", err)
+			return nil
+		}
+		r.communityResolverTaskManifest = communityResolverTaskManifest
+	}
 	if len(r.communityResolverTaskManifest.Resources()) == 0 {
 		return nil
 	}
 	manifest := *r.communityResolverTaskManifest
-	if enable == "true" {
-		if err := r.installerSetClient.CustomSet(ctx, ta, CommunityResolverTaskInstallerSet, &manifest, filterAndTransformCommunityResolverTask(), nil); err != nil {
-			return err
-		}
-	} else {
-		if err := r.installerSetClient.CleanupCustomSet(ctx, CommunityResolverTaskInstallerSet); err != nil {
-			return err
-		}
-	}
-	return nil
+	return r.installerSetClient.CustomSet(ctx, ta, CommunityResolverTaskInstallerSet, &manifest, filterAndTransformCommunityResolverTask(), nil)
 }
 
 func filterAndTransformCommunityResolverTask() client.FilterAndTransform {
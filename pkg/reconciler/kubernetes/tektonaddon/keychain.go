@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonaddon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/system"
+)
+
+// operatorPodNameEnv is the name of the operator pod, set with the downward API
+const operatorPodNameEnv = "POD_NAME"
+
+// pullSecretsKeychain holds the registry credentials of the image pull secrets by registry host
+type pullSecretsKeychain map[string]authn.AuthConfig
+
+// Resolve returns the credentials of the registry of the target, anonymous if there are none
+func (k pullSecretsKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	if cfg, ok := k[target.RegistryStr()]; ok {
+		return authn.FromConfig(cfg), nil
+	}
+	return authn.Anonymous, nil
+}
+
+// serviceAccountKeychain returns the credentials of the image pull secrets of the operator pod
+// and of its ServiceAccount, the same the kubelet uses to pull the images of the operator
+func serviceAccountKeychain(ctx context.Context, kc kubernetes.Interface) (authn.Keychain, error) {
+	keychain := pullSecretsKeychain{}
+	podName := os.Getenv(operatorPodNameEnv)
+	if podName == "" {
+		return keychain, nil
+	}
+	namespace := system.Namespace()
+
+	pod, err := kc.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the operator pod %s/%s: %w", namespace, podName, err)
+	}
+	pullSecrets := pod.Spec.ImagePullSecrets
+	if pod.Spec.ServiceAccountName != "" {
+		sa, err := kc.CoreV1().ServiceAccounts(namespace).Get(ctx, pod.Spec.ServiceAccountName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the operator service account %s/%s: %w", namespace, pod.Spec.ServiceAccountName, err)
+		}
+		pullSecrets = append(pullSecrets, sa.ImagePullSecrets...)
+	}
+
+	for _, ref := range pullSecrets {
+		secret, err := kc.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			// a missing pull secret is skipped, like the kubelet does
+			if apierrs.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		auths, err := dockerConfigAuths(secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read pull secret %s/%s: %w", namespace, ref.Name, err)
+		}
+		for registry, cfg := range auths {
+			host := registryHost(registry)
+			// the first secret holding a registry wins
+			if _, ok := keychain[host]; !ok {
+				keychain[host] = cfg
+			}
+		}
+	}
+	return keychain, nil
+}
+
+// dockerConfigAuths returns the credentials by registry of a dockerconfigjson or dockercfg secret
+func dockerConfigAuths(secret *corev1.Secret) (map[string]authn.AuthConfig, error) {
+	auths := map[string]authn.AuthConfig{}
+	switch secret.Type {
+	case corev1.SecretTypeDockerConfigJson:
+		config := struct {
+			Auths map[string]authn.AuthConfig `json:"auths"`
+		}{}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			return nil, err
+		}
+		auths = config.Auths
+	case corev1.SecretTypeDockercfg:
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &auths); err != nil {
+			return nil, err
+		}
+	}
+	return auths, nil
+}
+
+// registryHost returns the host of a registry key of a docker config, which
+// can be a URL like https://index.docker.io/v1/
+func registryHost(registry string) string {
+	registry = strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://")
+	if i := strings.Index(registry, "/"); i >= 0 {
+		registry = registry[:i]
+	}
+	return registry
+}
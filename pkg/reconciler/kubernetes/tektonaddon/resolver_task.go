@@ -60,7 +60,7 @@ func (r *Reconciler) getTransformer(ctx context.Context, kind string, isVersione
 func (r *Reconciler) ensureCustomSet(ctx context.Context, enable, installerSetName string, ta *v1alpha1.TektonAddon,
 	manifest mf.Manifest, tfs []mf.Transformer) error {
 	if enable == "true" {
-		if err := r.installerSetClient.CustomSet(ctx, ta, installerSetName, &manifest, filterAndTransformResolverTask(tfs), nil); err != nil {
+		if err := r.installerSetClient.CustomSet(ctx, ta, installerSetName, &manifest, r.filterAndTransformResolverTask(tfs), nil); err != nil {
 			return err
 		}
 	} else {
@@ -71,10 +71,10 @@ func (r *Reconciler) ensureCustomSet(ctx context.Context, enable, installerSetNa
 	return nil
 }
 
-func filterAndTransformResolverTask(tfs []mf.Transformer) client.FilterAndTransform {
+func (r *Reconciler) filterAndTransformResolverTask(tfs []mf.Transformer) client.FilterAndTransform {
 	return func(ctx context.Context, manifest *mf.Manifest, comp v1alpha1.TektonComponent) (*mf.Manifest, error) {
 		addon := comp.(*v1alpha1.TektonAddon)
-		if err := transformers(ctx, manifest, addon, tfs...); err != nil {
+		if err := r.transformers(ctx, manifest, addon, tfs...); err != nil {
 			return nil, err
 		}
 		return manifest, nil
@@ -36,7 +36,7 @@ func (r *Reconciler) EnsureVersionedResolverStepAction(ctx context.Context, enab
 func (r *Reconciler) ensureVersionedCustomSet(ctx context.Context, enable, installerSetType, installerSetName string, ta *v1alpha1.TektonAddon,
 	manifest mf.Manifest, tfs []mf.Transformer) error {
 	if enable == "true" {
-		if err := r.installerSetClient.VersionedTaskSet(ctx, ta, &manifest, filterAndTransformResolverTask(tfs),
+		if err := r.installerSetClient.VersionedTaskSet(ctx, ta, &manifest, r.filterAndTransformResolverTask(tfs),
 			installerSetType, installerSetName); err != nil {
 			return err
 		}
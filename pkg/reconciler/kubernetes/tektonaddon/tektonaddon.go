@@ -31,7 +31,8 @@ import (
 	tektonaddonreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonaddon"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
-	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
@@ -39,22 +40,19 @@ import (
 // Reconciler implements controller.Reconciler for TektonAddon resources.
 type Reconciler struct {
 	// installer Set client to do CRUD operations for components
-	installerSetClient *client.InstallerSetClient
-	// crdClientSet allows us to talk to the k8s for core APIs
-	crdClientSet                  *apiextensionsclient.Clientset
+	installerSetClient            *client.InstallerSetClient
 	manifest                      mf.Manifest
 	operatorClientSet             clientset.Interface
 	extension                     common.Extension
 	pipelineInformer              informer.TektonPipelineInformer
-	triggerInformer               informer.TektonTriggerInformer
 	operatorVersion               string
 	resolverTaskManifest          *mf.Manifest
 	resolverStepActionManifest    *mf.Manifest
-	triggersResourcesManifest     *mf.Manifest
-	pipelineTemplateManifest      *mf.Manifest
-	openShiftConsoleManifest      *mf.Manifest
-	consoleCLIManifest            *mf.Manifest
 	communityResolverTaskManifest *mf.Manifest
+	// kubeClientSet allows us to read the ConfigMaps of the catalogs
+	kubeClientSet kubernetes.Interface
+	// catalogCache keeps the fetched content of the user defined catalogs
+	catalogCache *catalogCache
 }
 
 const (
@@ -102,8 +100,8 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, ta *v1alpha1.TektonAddon
 	// Pass the object through defaulting
 	ta.SetDefaults(ctx)
 
-	// Make sure TektonPipeline & TektonTrigger is installed before proceeding with
-	// TektonAddons
+	// Make sure TektonPipeline is installed before proceeding with
+	// TektonAddons, the platform extension checks its own dependencies
 
 	if _, err := common.PipelineReady(r.pipelineInformer); err != nil {
 		if err.Error() == common.PipelineNotReady || err == v1alpha1.DEPENDENCY_UPGRADE_PENDING_ERR {
@@ -116,24 +114,13 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, ta *v1alpha1.TektonAddon
 		return err
 	}
 
-	if _, err := common.TriggerReady(r.triggerInformer); err != nil {
-		if err.Error() == common.TriggerNotReady || err == v1alpha1.DEPENDENCY_UPGRADE_PENDING_ERR {
-			ta.Status.MarkDependencyInstalling("tekton-triggers is still installing")
-			// wait for trigger status to change
-			return v1alpha1.REQUEUE_EVENT_AFTER
-		}
-		// (tektontrigger.operator.tekton.dev instance not available yet)
-		ta.Status.MarkDependencyMissing("tekton-triggers does not exist")
-		return err
-	}
-
 	ta.Status.MarkDependenciesInstalled()
 
 	// validate the params
-	ptVal, _ := findValue(ta.Spec.Params, v1alpha1.PipelineTemplatesParam)
-	rtVal, _ := findValue(ta.Spec.Params, v1alpha1.ResolverTasks)
-	rsaVal, _ := findValue(ta.Spec.Params, v1alpha1.ResolverStepActions)
-	ctVal, _ := findValue(ta.Spec.Params, v1alpha1.CommunityResolverTasks)
+	ptVal, _ := FindValue(ta.Spec.Params, v1alpha1.PipelineTemplatesParam)
+	rtVal, _ := FindValue(ta.Spec.Params, v1alpha1.ResolverTasks)
+	rsaVal, _ := FindValue(ta.Spec.Params, v1alpha1.ResolverStepActions)
+	ctVal, _ := FindValue(ta.Spec.Params, v1alpha1.CommunityResolverTasks)
 
 	if ptVal == "true" && rtVal == "false" {
 		ta.Status.MarkNotReady("pipelineTemplates cannot be true if ResolverTask is false")
@@ -179,56 +166,48 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, ta *v1alpha1.TektonAddon
 		logger.Error(errorMsg)
 	}
 
-	if err := r.EnsurePipelineTemplates(ctx, ptVal, ta); err != nil {
-		ready = false
-		errorMsg = fmt.Sprintf("pipelines templates not yet ready:  %v", err)
-		logger.Error(errorMsg)
-	}
-
-	if err := r.EnsureTriggersResources(ctx, ta); err != nil {
-		ready = false
-		errorMsg = fmt.Sprintf("triggers resources not yet ready:  %v", err)
-		logger.Error(errorMsg)
-	}
-
-	err, consoleCLIDownloadExist := r.EnsureOpenShiftConsoleResources(ctx, ta)
-	if err != nil {
+	if err := r.EnsureCommunityResolverTask(ctx, ctVal, ta); err != nil {
 		ready = false
-		errorMsg = fmt.Sprintf("openshift console resources not yet ready:  %v", err)
+		errorMsg = fmt.Sprintf("community tasks not yet ready:  %v", err)
 		logger.Error(errorMsg)
 	}
 
-	if consoleCLIDownloadExist {
-		if err := r.EnsureConsoleCLI(ctx, ta); err != nil {
-			ready = false
-			errorMsg = fmt.Sprintf("console cli not yet ready:  %v", err)
-			logger.Error(errorMsg)
-		}
-	}
-
-	if err := r.EnsureCommunityResolverTask(ctx, ctVal, ta); err != nil {
+	refreshInterval, catalogErr := r.EnsureCatalogs(ctx, ta)
+	if catalogErr != nil {
 		ready = false
-		errorMsg = fmt.Sprintf("community tasks not yet ready:  %v", err)
+		errorMsg = fmt.Sprintf("catalogs not yet ready:  %v", catalogErr)
 		logger.Error(errorMsg)
 	}
 
 	if !ready {
 		ta.Status.MarkInstallerSetNotReady(errorMsg)
+		// no event follows a failed fetch of a catalog, retry it later
+		if catalogErr != nil {
+			return v1alpha1.REQUEUE_EVENT_AFTER
+		}
 		return nil
 	}
 
 	ta.Status.MarkInstallerSetReady()
 
+	// the platform extension installs the platform specific addons, like the
+	// pipeline templates and the console resources on OpenShift
 	if err := r.extension.PostReconcile(ctx, ta); err != nil {
 		ta.Status.MarkPostReconcilerFailed(err.Error())
 		return err
 	}
 
 	ta.Status.MarkPostReconcilerComplete()
+
+	// fetch the catalogs again once the refresh interval is elapsed
+	if refreshInterval > 0 {
+		return controller.NewRequeueAfter(refreshInterval)
+	}
 	return nil
 }
 
-func applyAddons(manifest *mf.Manifest, subpath string) error {
+// ApplyAddons appends the addon resources of kodata tekton-addon/addons/<subpath> to the manifest
+func ApplyAddons(manifest *mf.Manifest, subpath string) error {
 	koDataDir := os.Getenv(common.KoEnvKey)
 	addonLocation := filepath.Join(koDataDir, "tekton-addon", "addons", subpath)
 	addons, err := mf.ManifestFrom(mf.Recursive(addonLocation))
@@ -253,7 +232,8 @@ func applyAddons(manifest *mf.Manifest, subpath string) error {
 	return nil
 }
 
-func findValue(params []v1alpha1.Param, name string) (string, bool) {
+// FindValue returns the value of the addon param
+func FindValue(params []v1alpha1.Param, name string) (string, bool) {
 	for _, p := range params {
 		if p.Name == name {
 			return p.Value, true
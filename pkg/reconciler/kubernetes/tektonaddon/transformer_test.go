@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonaddon
+
+import (
+	"path"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/pipeline/test/diff"
+	"gotest.tools/v3/assert"
+)
+
+func TestSetVersionedNames(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputPath    string
+		expectedPath string
+		errorMessage string
+	}{
+		{
+			name:         "test for versioned resolver task",
+			inputPath:    "test-versioned-resolvertask-name.yaml",
+			expectedPath: "test-versioned-resolver-name-expected.yaml",
+			errorMessage: "failed to update versioned resolver task name %s",
+		},
+		{
+			name:         "test for versioned resolver stepaction",
+			inputPath:    "test-versioned-resolverstepaction-name.yaml",
+			expectedPath: "test-versioned-resolver-stepaction-expected.yaml",
+			errorMessage: "failed to update versioned resolver stepaction name %s",
+		}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testData := path.Join("testdata", tt.inputPath)
+			manifest, err := mf.ManifestFrom(mf.Recursive(testData))
+			assert.NilError(t, err)
+
+			testData = path.Join("testdata", tt.expectedPath)
+			expectedManifest, err := mf.ManifestFrom(mf.Recursive(testData))
+			assert.NilError(t, err)
+
+			operatorVersion := "v1.7.0"
+			newManifest, err := manifest.Transform(setVersionedNames(operatorVersion))
+			assert.NilError(t, err)
+
+			if d := cmp.Diff(expectedManifest.Resources(), newManifest.Resources()); d != "" {
+				t.Errorf(tt.errorMessage, diff.PrintWantGot(d))
+			}
+		})
+	}
+}
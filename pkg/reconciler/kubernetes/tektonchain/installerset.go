@@ -41,7 +41,10 @@ func (r *Reconciler) createSecretInstallerSet(ctx context.Context, tc *v1alpha1.
 	}
 
 	// generate installer set
-	tis := makeInstallerSet(tc, manifest, secretChainInstallerset, "", r.operatorVersion)
+	tis, err := makeInstallerSet(tc, manifest, secretChainInstallerset, "", r.operatorVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	// Add annoation to secret installer set in case the generate secret signing is set to true
 	if tc.Spec.GenerateSigningSecret {
@@ -69,7 +72,10 @@ func (r *Reconciler) createConfigInstallerSet(ctx context.Context, tc *v1alpha1.
 	}
 
 	// generate installer set
-	tis := makeInstallerSet(tc, manifest, configChainInstallerset, "", r.operatorVersion)
+	tis, err := makeInstallerSet(tc, manifest, configChainInstallerset, "", r.operatorVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	// compute the hash of tektonchain spec and store as an annotation
 	// in further reconciliation we compute hash of tc spec and check with
@@ -121,7 +127,10 @@ func (r *Reconciler) createInstallerSet(ctx context.Context, tc *v1alpha1.Tekton
 		installerSetInstallType = client.InstallerSubTypeStatefulset
 	}
 	// generate installer set
-	tis := makeInstallerSet(tc, manifest, v1alpha1.ChainResourceName, installerSetInstallType, r.operatorVersion)
+	tis, err := makeInstallerSet(tc, manifest, v1alpha1.ChainResourceName, installerSetInstallType, r.operatorVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	// compute the hash of tektonchain spec and store as an annotation
 	// in further reconciliation we compute hash of tc spec and check with
@@ -142,7 +151,7 @@ func (r *Reconciler) createInstallerSet(ctx context.Context, tc *v1alpha1.Tekton
 	return createdIs, nil
 }
 
-func makeInstallerSet(tc *v1alpha1.TektonChain, manifest mf.Manifest, installerSetType, installerSetInstallType, releaseVersion string) *v1alpha1.TektonInstallerSet {
+func makeInstallerSet(tc *v1alpha1.TektonChain, manifest mf.Manifest, installerSetType, installerSetInstallType, releaseVersion string) (*v1alpha1.TektonInstallerSet, error) {
 	ownerRef := *metav1.NewControllerRef(tc, tc.GetGroupVersionKind())
 	labels := map[string]string{
 		v1alpha1.CreatedByKey:      createdByValue,
@@ -153,7 +162,7 @@ func makeInstallerSet(tc *v1alpha1.TektonChain, manifest mf.Manifest, installerS
 	if installerSetInstallType != "" {
 		labels[v1alpha1.InstallerSetInstallType] = installerSetInstallType
 	}
-	return &v1alpha1.TektonInstallerSet{
+	tis := &v1alpha1.TektonInstallerSet{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: fmt.Sprintf("%s-", installerSetType),
 			Labels:       labels,
@@ -162,8 +171,9 @@ func makeInstallerSet(tc *v1alpha1.TektonChain, manifest mf.Manifest, installerS
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			Manifests: manifest.Resources(),
-		},
 	}
+	if err := tis.Spec.SetManifests(manifest.Resources()); err != nil {
+		return nil, err
+	}
+	return tis, nil
 }
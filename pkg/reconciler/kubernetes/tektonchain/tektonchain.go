@@ -40,6 +40,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
@@ -69,6 +70,8 @@ type Reconciler struct {
 
 	// operatorClientSet allows us to configure operator objects
 	operatorClientSet clientset.Interface
+	// kubeClientSet allows us to talk to the k8s for core APIs
+	kubeClientSet kubernetes.Interface
 	// manifest has the source manifest of Tekton Triggers for a
 	// particular version
 	manifest mf.Manifest
@@ -175,6 +178,12 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 	tc.Status.MarkPreReconcilerComplete()
 	logger.Debug("PreReconcile completed successfully")
 
+	// Ensure webhook deadlock prevention before applying the manifest
+	if err := common.PreemptDeadlock(ctx, &r.manifest, r.kubeClientSet, tc); err != nil {
+		logger.Errorw("Failed to preempt webhook deadlock", "error", err)
+		return err
+	}
+
 	// Fetching and deleting the chains tektoninstallerset to delete `chains-config` configMap
 	// to handle the scenario when user upgrades i.e. in previous version `chains-config` configMap
 	// installerset was not there and with latest version we create separate installerset for
@@ -483,7 +492,9 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 			installedTIS.SetAnnotations(current)
 
 			// Update the manifests
-			installedTIS.Spec.Manifests = manifest.Resources()
+			if err := installedTIS.Spec.SetManifests(manifest.Resources()); err != nil {
+				return err
+			}
 
 			if _, err = r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
 				Update(ctx, installedTIS, metav1.UpdateOptions{}); err != nil {
@@ -595,7 +606,9 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonChain
 		installedSecretTIS.Annotations[secretTISSigningAnnotation] = strconv.FormatBool(tc.Spec.GenerateSigningSecret)
 
 		// Update the manifests
-		installedSecretTIS.Spec.Manifests = manifest.Resources()
+		if err := installedSecretTIS.Spec.SetManifests(manifest.Resources()); err != nil {
+			return err
+		}
 
 		if _, err = r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
 			Update(ctx, installedSecretTIS, metav1.UpdateOptions{}); err != nil {
@@ -26,16 +26,47 @@ import (
 	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonconfig/extension"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/addon"
+	"go.uber.org/zap"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/kubernetes"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
 )
 
 func KubernetesExtension(ctx context.Context) common.Extension {
+	logger := logging.FromContext(ctx)
+	operatorVer, err := common.OperatorVersion(ctx)
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	crdClient, err := apiextensionsclient.NewForConfig(injection.GetConfig(ctx))
+	if err != nil {
+		logger.Fatalw("Error creating client from injected config", zap.Error(err))
+	}
+
+	tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
 	return kubernetesExtension{
 		operatorClientSet: operatorclient.Get(ctx),
+		kubeClientSet:     kubeclient.Get(ctx),
+		operatorVersion:   operatorVer,
+		crdClientSet:      crdClient,
+		// the monitoring resources and the dashboards are shipped with the operator
+		monitoringInstallerSetClient: client.NewInstallerSetClient(tisClient, operatorVer, operatorVer, v1alpha1.KindTektonConfig, nil),
+		dashboardsInstallerSetClient: client.NewInstallerSetClient(tisClient, operatorVer, operatorVer, v1alpha1.KindTektonConfig, nil),
 	}
 }
 
 type kubernetesExtension struct {
-	operatorClientSet versioned.Interface
+	operatorClientSet            versioned.Interface
+	kubeClientSet                kubernetes.Interface
+	operatorVersion              string
+	crdClientSet                 apiextensionsclient.Interface
+	monitoringInstallerSetClient *client.InstallerSetClient
+	dashboardsInstallerSetClient *client.InstallerSetClient
 }
 
 func (oe kubernetesExtension) Transformers(comp v1alpha1.TektonComponent) []mf.Transformer {
@@ -47,22 +78,53 @@ func (oe kubernetesExtension) PreReconcile(context.Context, v1alpha1.TektonCompo
 func (oe kubernetesExtension) PostReconcile(ctx context.Context, comp v1alpha1.TektonComponent) error {
 	configInstance := comp.(*v1alpha1.TektonConfig)
 
-	if configInstance.Spec.Profile == v1alpha1.ProfileAll {
+	profile, err := common.TektonProfile(ctx, oe.operatorClientSet, configInstance.Spec.Profile)
+	if err != nil {
+		return err
+	}
+
+	if profile.Enables(v1alpha1.ComponentDashboard) {
 		if _, err := extension.EnsureTektonDashboardExists(ctx, oe.operatorClientSet.OperatorV1alpha1().TektonDashboards(), configInstance); err != nil {
 			configInstance.Status.MarkPostInstallFailed(fmt.Sprintf("TektonDashboard: %s", err.Error()))
 			return v1alpha1.REQUEUE_EVENT_AFTER
 		}
+	} else if err := extension.EnsureTektonDashboardCRNotExists(ctx, oe.operatorClientSet.OperatorV1alpha1().TektonDashboards()); err != nil {
+		return err
 	}
 
-	if configInstance.Spec.Profile == v1alpha1.ProfileLite || configInstance.Spec.Profile == v1alpha1.ProfileBasic {
-		return extension.EnsureTektonDashboardCRNotExists(ctx, oe.operatorClientSet.OperatorV1alpha1().TektonDashboards())
+	if profile.Enables(v1alpha1.ComponentAddon) {
+		if _, err := addon.EnsureTektonAddonExists(ctx, oe.operatorClientSet.OperatorV1alpha1().TektonAddons(), configInstance, oe.operatorVersion); err != nil {
+			configInstance.Status.MarkComponentNotReady(fmt.Sprintf("TektonAddon: %s", err.Error()))
+			return v1alpha1.REQUEUE_EVENT_AFTER
+		}
+	} else if err := addon.EnsureTektonAddonCRNotExists(ctx, oe.operatorClientSet.OperatorV1alpha1().TektonAddons()); err != nil {
+		return err
+	}
+
+	if err := extension.EnsureMonitoring(ctx, oe.crdClientSet, oe.monitoringInstallerSetClient, configInstance, profile); err != nil {
+		configInstance.Status.MarkPostInstallFailed(fmt.Sprintf("Monitoring: %s", err.Error()))
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+
+	if err := extension.EnsureGrafanaDashboards(ctx, oe.dashboardsInstallerSetClient, configInstance, profile); err != nil {
+		configInstance.Status.MarkPostInstallFailed(fmt.Sprintf("Grafana dashboards: %s", err.Error()))
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+
+	if err := extension.EnsurePodSecurity(ctx, oe.kubeClientSet, configInstance); err != nil {
+		configInstance.Status.MarkPostInstallFailed(fmt.Sprintf("Pod Security: %s", err.Error()))
+		return v1alpha1.REQUEUE_EVENT_AFTER
 	}
 
 	return nil
 }
 func (oe kubernetesExtension) Finalize(ctx context.Context, comp v1alpha1.TektonComponent) error {
 	configInstance := comp.(*v1alpha1.TektonConfig)
-	if configInstance.Spec.Profile == v1alpha1.ProfileAll {
+	// a TektonProfile may have installed them
+	if configInstance.Spec.Profile != v1alpha1.ProfileLite && configInstance.Spec.Profile != v1alpha1.ProfileBasic {
+		if err := addon.EnsureTektonAddonCRNotExists(ctx, oe.operatorClientSet.OperatorV1alpha1().TektonAddons()); err != nil {
+			return err
+		}
 		return extension.EnsureTektonDashboardCRNotExists(ctx, oe.operatorClientSet.OperatorV1alpha1().TektonDashboards())
 	}
 	return nil
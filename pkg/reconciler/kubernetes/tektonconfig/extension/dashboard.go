@@ -73,7 +73,7 @@ func createDashboard(ctx context.Context, clients op.TektonDashboardInterface, c
 			CommonSpec: v1alpha1.CommonSpec{
 				TargetNamespace: config.Spec.TargetNamespace,
 			},
-			Config:    config.Spec.Config,
+			Config:    config.Spec.Config.WithOverride(config.Spec.ComponentConfig.Dashboard),
 			Dashboard: config.Spec.Dashboard,
 		},
 	}
@@ -95,8 +95,8 @@ func updateDashboard(ctx context.Context, tdCR *v1alpha1.TektonDashboard, config
 		updated = true
 	}
 
-	if !reflect.DeepEqual(tdCR.Spec.Config, config.Spec.Config) {
-		tdCR.Spec.Config = config.Spec.Config
+	if dashboardConfig := config.Spec.Config.WithOverride(config.Spec.ComponentConfig.Dashboard); !reflect.DeepEqual(tdCR.Spec.Config, dashboardConfig) {
+		tdCR.Spec.Config = dashboardConfig
 		updated = true
 	}
 
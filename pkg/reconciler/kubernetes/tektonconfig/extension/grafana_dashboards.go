@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// GrafanaDashboardsInstallerSet is the custom installer set of the dashboards ConfigMaps
+	GrafanaDashboardsInstallerSet = "grafana-dashboards"
+
+	// componentReleaseAnnotation records the release of the component the dashboards were picked for
+	componentReleaseAnnotation = "operator.tekton.dev/component-release"
+)
+
+// defaultDashboardLabels is the label watched by the grafana sidecar of the grafana helm chart
+var defaultDashboardLabels = map[string]string{"grafana_dashboard": "1"}
+
+// dashboardComponents are the components shipping Grafana dashboards
+var dashboardComponents = []struct {
+	component string
+	partOf    string
+	instance  v1alpha1.TektonComponent
+}{
+	{v1alpha1.ComponentPipeline, "tekton-pipelines", &v1alpha1.TektonPipeline{}},
+	{v1alpha1.ComponentChain, "tekton-chains", &v1alpha1.TektonChain{}},
+	{v1alpha1.ComponentResult, "tekton-results", &v1alpha1.TektonResult{}},
+}
+
+// EnsureGrafanaDashboards creates a ConfigMap per Grafana dashboard of the installed components when
+// the dashboards are enabled, the installer set is recreated with the components on operator upgrades
+func EnsureGrafanaDashboards(ctx context.Context, installerSetClient *client.InstallerSetClient, tc *v1alpha1.TektonConfig, profile v1alpha1.TektonProfileSpec) error {
+	if tc.Spec.Monitoring == nil || tc.Spec.Monitoring.Dashboards == nil || !tc.Spec.Monitoring.Dashboards.Enabled {
+		return installerSetClient.CleanupCustomSet(ctx, GrafanaDashboardsInstallerSet)
+	}
+
+	manifest, err := GrafanaDashboardsManifest(tc, profile)
+	if err != nil {
+		return err
+	}
+	return installerSetClient.CustomSet(ctx, tc, GrafanaDashboardsInstallerSet, manifest, noTransform, nil)
+}
+
+// GrafanaDashboardsManifest returns the dashboards ConfigMaps of the installed components, matching
+// the releases bundled with the operator
+func GrafanaDashboardsManifest(tc *v1alpha1.TektonConfig, profile v1alpha1.TektonProfileSpec) (*mf.Manifest, error) {
+	dashboards := tc.Spec.Monitoring.Dashboards
+	namespace := dashboards.Namespace
+	if namespace == "" {
+		namespace = tc.Spec.GetTargetNamespace()
+	}
+	labels := dashboards.Labels
+	if len(labels) == 0 {
+		labels = defaultDashboardLabels
+	}
+
+	resources := []unstructured.Unstructured{}
+	for _, dc := range dashboardComponents {
+		if !componentInstalled(tc, profile, dc.component) {
+			continue
+		}
+		release, files, err := common.GrafanaDashboards(dc.instance)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			u, err := dashboardConfigMap(name, files[name], namespace, dc.partOf, release, labels)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, u)
+		}
+	}
+
+	manifest, err := mf.ManifestFrom(mf.Slice(resources))
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+func dashboardConfigMap(file, dashboard, namespace, partOf, release string, labels map[string]string) (unstructured.Unstructured, error) {
+	cmLabels := map[string]string{
+		"app.kubernetes.io/managed-by": monitoringManagedBy,
+		monitoringPartOfLabel:          partOf,
+	}
+	for key, value := range labels {
+		cmLabels[key] = value
+	}
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "grafana-dashboard-" + strings.TrimSuffix(file, ".json"),
+			Namespace:   namespace,
+			Labels:      cmLabels,
+			Annotations: map[string]string{componentReleaseAnnotation: release},
+		},
+		Data: map[string]string{file: dashboard},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: obj}, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	isfake "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pipeline"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGrafanaDashboardsManifest(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	tc := pipeline.GetTektonConfig()
+	tc.Spec.Monitoring = &v1alpha1.Monitoring{Dashboards: &v1alpha1.GrafanaDashboards{Enabled: true}}
+	profile, _ := v1alpha1.BuiltinProfile(v1alpha1.ProfileAll)
+
+	manifest, err := GrafanaDashboardsManifest(tc, profile)
+	assert.NilError(t, err)
+
+	// chains have no dashboards in the test kodata
+	resources := manifest.Resources()
+	assert.Equal(t, len(resources), 2)
+	cm := resources[0]
+	assert.Equal(t, cm.GetKind(), "ConfigMap")
+	assert.Equal(t, cm.GetName(), "grafana-dashboard-tekton-pipelines")
+	assert.Equal(t, cm.GetNamespace(), "tekton-pipelines")
+	assert.Equal(t, cm.GetLabels()["grafana_dashboard"], "1")
+	assert.Equal(t, cm.GetLabels()["app.kubernetes.io/part-of"], "tekton-pipelines")
+	assert.Equal(t, cm.GetAnnotations()[componentReleaseAnnotation], "1.0.0")
+	assert.DeepEqual(t, cm.Object["data"], map[string]interface{}{
+		"tekton-pipelines.json": `{"uid": "tekton-pipelines", "title": "Tekton / Pipelines"}` + "\n",
+	})
+	assert.Equal(t, resources[1].GetName(), "grafana-dashboard-tekton-results")
+
+	tc.Spec.Result.Disabled = true
+	tc.Spec.Monitoring.Dashboards.Namespace = "grafana"
+	tc.Spec.Monitoring.Dashboards.Labels = map[string]string{"dashboards": "tekton"}
+	manifest, err = GrafanaDashboardsManifest(tc, profile)
+	assert.NilError(t, err)
+	resources = manifest.Resources()
+	assert.Equal(t, len(resources), 1)
+	assert.Equal(t, resources[0].GetNamespace(), "grafana")
+	assert.Equal(t, resources[0].GetLabels()["dashboards"], "tekton")
+	assert.Equal(t, resources[0].GetLabels()["grafana_dashboard"], "")
+}
+
+func TestEnsureGrafanaDashboards(t *testing.T) {
+	t.Setenv(common.KoEnvKey, "testdata/kodata")
+	ctx := context.Background()
+	tc := pipeline.GetTektonConfig()
+	profile, _ := v1alpha1.BuiltinProfile(v1alpha1.ProfileAll)
+	isClient := isfake.NewFakeISClient()
+	installerSetClient := client.NewInstallerSetClient(isClient, "devel", "devel", v1alpha1.KindTektonConfig, nil)
+
+	listSets := func() []v1alpha1.TektonInstallerSet {
+		list, err := isClient.List(ctx, metav1.ListOptions{})
+		assert.NilError(t, err)
+		return list.Items
+	}
+
+	// disabled
+	assert.NilError(t, EnsureGrafanaDashboards(ctx, installerSetClient, tc, profile))
+	assert.Equal(t, len(listSets()), 0)
+
+	// enabled, the installer set is created and waits for its status
+	tc.Spec.Monitoring = &v1alpha1.Monitoring{Dashboards: &v1alpha1.GrafanaDashboards{Enabled: true}}
+	err := EnsureGrafanaDashboards(ctx, installerSetClient, tc, profile)
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	sets := listSets()
+	assert.Equal(t, len(sets), 1)
+	resources, err := sets[0].Spec.GetManifests()
+	assert.NilError(t, err)
+	assert.Equal(t, len(resources), 2)
+
+	// disabled again, the installer set is removed
+	tc.Spec.Monitoring.Dashboards.Enabled = false
+	assert.NilError(t, EnsureGrafanaDashboards(ctx, installerSetClient, tc, profile))
+	assert.Equal(t, len(listSets()), 0)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// MonitoringInstallerSet is the custom installer set of the ServiceMonitors and the PrometheusRule
+	MonitoringInstallerSet = "monitoring"
+
+	monitoringAPIVersion  = "monitoring.coreos.com/v1"
+	defaultScrapeInterval = "30s"
+	prometheusRuleName    = "tekton-alerts"
+	monitoringPartOfLabel = "app.kubernetes.io/part-of"
+	monitoringManagedBy   = "tekton-operator"
+)
+
+// monitoringCRDs are installed by the prometheus-operator
+var monitoringCRDs = []string{
+	"servicemonitors.monitoring.coreos.com",
+	"prometheusrules.monitoring.coreos.com",
+}
+
+// monitoredComponents are the components exposing metrics, with the part-of label of their services
+var monitoredComponents = []struct {
+	component string
+	partOf    string
+}{
+	{v1alpha1.ComponentPipeline, "tekton-pipelines"},
+	{v1alpha1.ComponentTrigger, "tekton-triggers"},
+	{v1alpha1.ComponentChain, "tekton-chains"},
+	{v1alpha1.ComponentResult, "tekton-results"},
+}
+
+// metricsPorts are the names of the metrics ports of the services of the components, a ServiceMonitor
+// endpoint is ignored for the services without the port
+var metricsPorts = []string{"http-metrics", "metrics", "prometheus"}
+
+// EnsureMonitoring creates the ServiceMonitors of the installed components and the PrometheusRule
+// when the monitoring is enabled and the prometheus-operator CRDs are installed
+func EnsureMonitoring(ctx context.Context, crdClient apiextensionsclient.Interface, installerSetClient *client.InstallerSetClient, tc *v1alpha1.TektonConfig, profile v1alpha1.TektonProfileSpec) error {
+	if tc.Spec.Monitoring == nil || !tc.Spec.Monitoring.Enabled {
+		return installerSetClient.CleanupCustomSet(ctx, MonitoringInstallerSet)
+	}
+
+	installed, err := monitoringCRDsInstalled(ctx, crdClient)
+	if err != nil {
+		return err
+	}
+	if !installed {
+		logging.FromContext(ctx).Infow("monitoring enabled, but the prometheus-operator CRDs are not installed, skipping", "crds", monitoringCRDs)
+		return nil
+	}
+
+	manifest, err := MonitoringManifest(tc, profile)
+	if err != nil {
+		return err
+	}
+	return installerSetClient.CustomSet(ctx, tc, MonitoringInstallerSet, manifest, noTransform, nil)
+}
+
+func noTransform(_ context.Context, manifest *mf.Manifest, _ v1alpha1.TektonComponent) (*mf.Manifest, error) {
+	return manifest, nil
+}
+
+func monitoringCRDsInstalled(ctx context.Context, crdClient apiextensionsclient.Interface) (bool, error) {
+	for _, name := range monitoringCRDs {
+		if _, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{}); err != nil {
+			if apierrs.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// MonitoringManifest returns a ServiceMonitor per installed component and the PrometheusRule
+func MonitoringManifest(tc *v1alpha1.TektonConfig, profile v1alpha1.TektonProfileSpec) (*mf.Manifest, error) {
+	monitoring := tc.Spec.Monitoring
+	namespace := tc.Spec.GetTargetNamespace()
+	interval := monitoring.Interval
+	if interval == "" {
+		interval = defaultScrapeInterval
+	}
+
+	resources := []unstructured.Unstructured{}
+	for _, mc := range monitoredComponents {
+		if !componentInstalled(tc, profile, mc.component) {
+			continue
+		}
+		endpoints := []interface{}{}
+		for _, port := range metricsPorts {
+			endpoints = append(endpoints, map[string]interface{}{"port": port, "interval": interval})
+		}
+		resources = append(resources, monitoringResource("ServiceMonitor", mc.partOf, namespace, monitoring.Labels, map[string]interface{}{
+			"jobLabel":          monitoringPartOfLabel,
+			"namespaceSelector": map[string]interface{}{"matchNames": []interface{}{namespace}},
+			"selector":          map[string]interface{}{"matchLabels": map[string]interface{}{monitoringPartOfLabel: mc.partOf}},
+			"endpoints":         endpoints,
+		}))
+	}
+
+	if !monitoring.DisableRules {
+		resources = append(resources, monitoringResource("PrometheusRule", prometheusRuleName, namespace, monitoring.Labels, map[string]interface{}{
+			"groups": []interface{}{map[string]interface{}{
+				"name":  "tekton.rules",
+				"rules": alertRules(namespace),
+			}},
+		}))
+	}
+
+	manifest, err := mf.ManifestFrom(mf.Slice(resources))
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// componentInstalled returns true if the profile installs the component and it is not disabled
+func componentInstalled(tc *v1alpha1.TektonConfig, profile v1alpha1.TektonProfileSpec, component string) bool {
+	switch component {
+	case v1alpha1.ComponentPipeline:
+		return true
+	case v1alpha1.ComponentTrigger:
+		return profile.Enables(component) && !tc.Spec.Trigger.Disabled
+	case v1alpha1.ComponentChain:
+		return profile.Enables(component) && !tc.Spec.Chain.Disabled
+	case v1alpha1.ComponentResult:
+		return profile.Enables(component) && !tc.Spec.Result.Disabled
+	}
+	return profile.Enables(component)
+}
+
+func monitoringResource(kind, name, namespace string, labels map[string]string, spec map[string]interface{}) unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	u.SetAPIVersion(monitoringAPIVersion)
+	u.SetKind(kind)
+	u.SetName(name)
+	u.SetNamespace(namespace)
+	resourceLabels := map[string]string{"app.kubernetes.io/managed-by": monitoringManagedBy}
+	for key, value := range labels {
+		resourceLabels[key] = value
+	}
+	u.SetLabels(resourceLabels)
+	return u
+}
+
+// alertRules are the alerts on the availability, the webhook latency and the workqueue depth of the
+// components, the metric names are the ones of the knative controllers and webhooks
+func alertRules(namespace string) []interface{} {
+	rule := func(alert, expr, duration, severity, summary string) interface{} {
+		return map[string]interface{}{
+			"alert":       alert,
+			"expr":        expr,
+			"for":         duration,
+			"labels":      map[string]interface{}{"severity": severity},
+			"annotations": map[string]interface{}{"summary": summary},
+		}
+	}
+	return []interface{}{
+		rule("TektonComponentDown",
+			fmt.Sprintf(`up{namespace=%q} == 0`, namespace),
+			"5m", "critical", "{{ $labels.service }} in {{ $labels.namespace }} is down"),
+		rule("TektonWebhookHighLatency",
+			fmt.Sprintf(`histogram_quantile(0.99, sum by (le, service) (rate(webhook_request_latencies_bucket{namespace=%q}[5m]))) > 1000`, namespace),
+			"10m", "warning", "the 99th percentile of the admission latency of {{ $labels.service }} is above 1s"),
+		rule("TektonWorkqueueDepthHigh",
+			fmt.Sprintf(`sum by (service) ({__name__=~".*workqueue_depth", namespace=%q}) > 100`, namespace),
+			"15m", "warning", "the workqueue of {{ $labels.service }} holds more than 100 keys"),
+	}
+}
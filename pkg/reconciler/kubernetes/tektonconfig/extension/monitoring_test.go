@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	isfake "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pipeline"
+	"gotest.tools/v3/assert"
+	apixv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apixfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMonitoringManifest(t *testing.T) {
+	tc := pipeline.GetTektonConfig()
+	tc.Spec.Chain.Disabled = true
+	tc.Spec.Monitoring = &v1alpha1.Monitoring{
+		Enabled: true,
+		Labels:  map[string]string{"release": "prometheus"},
+	}
+	profile, _ := v1alpha1.BuiltinProfile(v1alpha1.ProfileLite)
+
+	manifest, err := MonitoringManifest(tc, profile)
+	assert.NilError(t, err)
+
+	names := []string{}
+	for _, u := range manifest.Resources() {
+		names = append(names, u.GetKind()+"/"+u.GetName())
+		assert.Equal(t, u.GetNamespace(), "tekton-pipelines")
+		assert.Equal(t, u.GetLabels()["release"], "prometheus")
+	}
+	// lite does not install triggers, and chains are disabled
+	assert.DeepEqual(t, names, []string{"ServiceMonitor/tekton-pipelines", "ServiceMonitor/tekton-results", "PrometheusRule/tekton-alerts"})
+
+	sm := manifest.Filter(mf.ByName("tekton-pipelines")).Resources()[0]
+	endpoints := sm.Object["spec"].(map[string]interface{})["endpoints"].([]interface{})
+	assert.DeepEqual(t, endpoints[0], map[string]interface{}{"port": "http-metrics", "interval": "30s"})
+
+	tc.Spec.Monitoring.DisableRules = true
+	tc.Spec.Monitoring.Interval = "1m"
+	manifest, err = MonitoringManifest(tc, profile)
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Filter(mf.ByKind("PrometheusRule")).Resources()), 0)
+}
+
+func TestEnsureMonitoring(t *testing.T) {
+	ctx := context.Background()
+	tc := pipeline.GetTektonConfig()
+	profile, _ := v1alpha1.BuiltinProfile(v1alpha1.ProfileAll)
+	isClient := isfake.NewFakeISClient()
+	installerSetClient := client.NewInstallerSetClient(isClient, "devel", "devel", v1alpha1.KindTektonConfig, nil)
+	crdClient := apixfake.NewSimpleClientset()
+
+	listSets := func() []v1alpha1.TektonInstallerSet {
+		list, err := isClient.List(ctx, metav1.ListOptions{})
+		assert.NilError(t, err)
+		return list.Items
+	}
+
+	// disabled
+	assert.NilError(t, EnsureMonitoring(ctx, crdClient, installerSetClient, tc, profile))
+	assert.Equal(t, len(listSets()), 0)
+
+	// enabled without the prometheus-operator CRDs
+	tc.Spec.Monitoring = &v1alpha1.Monitoring{Enabled: true}
+	assert.NilError(t, EnsureMonitoring(ctx, crdClient, installerSetClient, tc, profile))
+	assert.Equal(t, len(listSets()), 0)
+
+	// enabled with the CRDs, the installer set is created and waits for its status
+	for _, name := range monitoringCRDs {
+		_, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Create(ctx, &apixv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		}, metav1.CreateOptions{})
+		assert.NilError(t, err)
+	}
+	err := EnsureMonitoring(ctx, crdClient, installerSetClient, tc, profile)
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	sets := listSets()
+	assert.Equal(t, len(sets), 1)
+	resources, err := sets[0].Spec.GetManifests()
+	assert.NilError(t, err)
+	assert.Equal(t, len(resources), 5)
+
+	// disabled again, the installer set is removed
+	tc.Spec.Monitoring.Enabled = false
+	assert.NilError(t, EnsureMonitoring(ctx, crdClient, installerSetClient, tc, profile))
+	assert.Equal(t, len(listSets()), 0)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+)
+
+var nsIgnoreRegex = regexp.MustCompile(common.NamespaceIgnorePattern)
+
+// EnsurePodSecurity labels the selected namespaces without a Pod Security level with the default
+// level, and removes the labels of the operator from the namespaces no longer selected
+func EnsurePodSecurity(ctx context.Context, kubeClientSet kubernetes.Interface, tc *v1alpha1.TektonConfig) error {
+	logger := logging.FromContext(ctx)
+	ps := tc.Spec.Platforms.Kubernetes.PodSecurity
+
+	// only the namespaces selected explicitly are labelled
+	selector := labels.Nothing()
+	if ps != nil && ps.Default != "" && ps.NamespaceSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(ps.NamespaceSelector); err != nil {
+			return err
+		}
+	}
+
+	namespaces, err := kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, ns := range namespaces.Items {
+		if nsIgnoreRegex.MatchString(ns.Name) || ns.Name == tc.Spec.GetTargetNamespace() || ns.DeletionTimestamp != nil {
+			continue
+		}
+		managed, isManaged := ns.Annotations[v1alpha1.PodSecurityDefaultAnnotation]
+		level, hasLevel := ns.Labels[v1alpha1.PodSecurityEnforceLabel]
+		// a level changed by the users is theirs
+		if isManaged && hasLevel && level != managed {
+			isManaged = false
+		}
+
+		switch {
+		case selector.Matches(labels.Set(ns.Labels)):
+			if (hasLevel && !isManaged) || (isManaged && level == ps.Default) {
+				continue
+			}
+			logger.Infof("labelling namespace %s with the pod security level %s", ns.Name, ps.Default)
+			if err := patchPodSecurity(ctx, kubeClientSet, ns, &ps.Default); err != nil {
+				return err
+			}
+		case isManaged:
+			logger.Infof("removing the pod security level %s of namespace %s", managed, ns.Name)
+			if err := patchPodSecurity(ctx, kubeClientSet, ns, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// patchPodSecurity sets the enforce and warn levels of the namespace, a nil level removes them
+func patchPodSecurity(ctx context.Context, kubeClientSet kubernetes.Interface, ns corev1.Namespace, level *string) error {
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{
+				v1alpha1.PodSecurityEnforceLabel: level,
+				v1alpha1.PodSecurityWarnLabel:    level,
+			},
+			"annotations": map[string]interface{}{
+				v1alpha1.PodSecurityDefaultAnnotation: level,
+			},
+		},
+	}
+	patchPayload, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the pod security patch for namespace %s: %w", ns.Name, err)
+	}
+	if _, err := kubeClientSet.CoreV1().Namespaces().Patch(ctx, ns.Name, types.MergePatchType, patchPayload, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to patch namespace %s: %w", ns.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pipeline"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEnsurePodSecurity(t *testing.T) {
+	ctx := context.Background()
+	namespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	kubeClient := k8sfake.NewSimpleClientset(
+		namespace("team-a", map[string]string{"pipelines": "true"}),
+		namespace("team-b", map[string]string{"pipelines": "true", v1alpha1.PodSecurityEnforceLabel: v1alpha1.PodSecurityBaseline}),
+		namespace("other", nil),
+		namespace("kube-system", nil),
+		namespace("tekton-pipelines", nil),
+	)
+	podSecurity := func(name string) (string, string) {
+		ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+		assert.NilError(t, err)
+		return ns.Labels[v1alpha1.PodSecurityEnforceLabel], ns.Annotations[v1alpha1.PodSecurityDefaultAnnotation]
+	}
+	assertLevel := func(name, level, managed string) {
+		t.Helper()
+		gotLevel, gotManaged := podSecurity(name)
+		assert.Equal(t, gotLevel, level, name)
+		assert.Equal(t, gotManaged, managed, name)
+	}
+
+	tc := pipeline.GetTektonConfig()
+
+	// no podSecurity, nothing is labelled
+	assert.NilError(t, EnsurePodSecurity(ctx, kubeClient, tc))
+	assertLevel("team-a", "", "")
+	assertLevel("other", "", "")
+
+	// no namespaceSelector, nothing is labelled
+	tc.Spec.Platforms.Kubernetes.PodSecurity = &v1alpha1.PodSecurity{Default: v1alpha1.PodSecurityRestricted}
+	assert.NilError(t, EnsurePodSecurity(ctx, kubeClient, tc))
+	assertLevel("team-a", "", "")
+	assertLevel("other", "", "")
+
+	// an empty namespaceSelector selects all the namespaces but the system and the target ones,
+	// the levels of the users are kept
+	tc.Spec.Platforms.Kubernetes.PodSecurity.NamespaceSelector = &metav1.LabelSelector{}
+	assert.NilError(t, EnsurePodSecurity(ctx, kubeClient, tc))
+	assertLevel("team-a", v1alpha1.PodSecurityRestricted, v1alpha1.PodSecurityRestricted)
+	assertLevel("team-b", v1alpha1.PodSecurityBaseline, "")
+	assertLevel("other", v1alpha1.PodSecurityRestricted, v1alpha1.PodSecurityRestricted)
+	assertLevel("kube-system", "", "")
+	assertLevel("tekton-pipelines", "", "")
+
+	// a new default relabels the namespaces labelled by the operator, the others are released
+	tc.Spec.Platforms.Kubernetes.PodSecurity.Default = v1alpha1.PodSecurityBaseline
+	tc.Spec.Platforms.Kubernetes.PodSecurity.NamespaceSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"pipelines": "true"},
+	}
+	assert.NilError(t, EnsurePodSecurity(ctx, kubeClient, tc))
+	assertLevel("team-a", v1alpha1.PodSecurityBaseline, v1alpha1.PodSecurityBaseline)
+	assertLevel("team-b", v1alpha1.PodSecurityBaseline, "")
+	assertLevel("other", "", "")
+
+	// removing podSecurity releases all the namespaces
+	tc.Spec.Platforms.Kubernetes.PodSecurity = nil
+	assert.NilError(t, EnsurePodSecurity(ctx, kubeClient, tc))
+	assertLevel("team-a", "", "")
+	assertLevel("team-b", v1alpha1.PodSecurityBaseline, "")
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonhub
+
+import (
+	"context"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+// apiDeploymentName is the deployment of the Hub API, which writes to the database
+const apiDeploymentName = "tekton-hub-api"
+
+// reconcileDBBackup schedules the backups of the operator managed database, and restores a backup
+// when TektonHub is annotated with operator.tekton.dev/restore-db-backup. A database provided by
+// the user, with a host other than the default one, is not backed up
+func (r *Reconciler) reconcileDBBackup(ctx context.Context, th *v1alpha1.TektonHub) error {
+	logger := logging.FromContext(ctx)
+	db := hubDBBackupDatabase(th)
+
+	backup := th.Spec.Db.Backup
+	if backup != nil {
+		secret, err := r.getSecret(ctx, databaseSecretName, th.Spec.GetTargetNamespace(), dbKeys)
+		if err != nil {
+			return err
+		}
+		if string(secret.Data[secretKeyPostgresHost]) != defaultPostgresHost {
+			logger.Warnw("backups are only supported for the operator managed database, skipping the backup",
+				"host", string(secret.Data[secretKeyPostgresHost]))
+			backup = nil
+		}
+	}
+	status, err := common.ReconcileDBBackup(ctx, r.kubeClientSet, db, backup, th.Status.DBBackup)
+	th.Status.DBBackup = status
+	if err != nil {
+		return err
+	}
+
+	if _, found := th.GetAnnotations()[v1alpha1.RestoreDBBackupKey]; !found {
+		return nil
+	}
+	backupName, restoreErr := common.RequestedDBRestore(th, th.Status.DBBackup)
+	if restoreErr == nil {
+		var finished bool
+		finished, restoreErr = common.RestoreDBBackup(ctx, r.kubeClientSet, db, backup, backupName)
+		if !finished {
+			if restoreErr != nil {
+				return restoreErr
+			}
+			return v1alpha1.RECONCILE_AGAIN_ERR
+		}
+	}
+	return r.finishDBRestore(ctx, th, backupName, restoreErr)
+}
+
+// finishDBRestore removes the restore annotation once the restore finished and reports the outcome
+func (r *Reconciler) finishDBRestore(ctx context.Context, th *v1alpha1.TektonHub, backupName string, restoreErr error) error {
+	logger := logging.FromContext(ctx)
+
+	annotations := th.GetAnnotations()
+	delete(annotations, v1alpha1.RestoreDBBackupKey)
+	th.SetAnnotations(annotations)
+	if _, err := r.operatorClientSet.OperatorV1alpha1().TektonHubs().Update(ctx, th, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	recorder := controller.GetEventRecorder(ctx)
+	if restoreErr != nil {
+		logger.Errorw("database restore failed", "backup", backupName, "error", restoreErr)
+		if recorder != nil {
+			recorder.Eventf(th, corev1.EventTypeWarning, "DBRestoreFailed", "%s", restoreErr.Error())
+		}
+		return nil
+	}
+
+	logger.Infow("database restored", "backup", backupName)
+	if th.Status.DBBackup == nil {
+		th.Status.DBBackup = &v1alpha1.DBBackupStatus{}
+	}
+	th.Status.DBBackup.LastRestoredBackup = backupName
+	now := metav1.Now()
+	th.Status.DBBackup.LastRestoreTime = &now
+	if recorder != nil {
+		recorder.Eventf(th, corev1.EventTypeNormal, "DBRestored", "database restored from the backup %s", backupName)
+	}
+	return nil
+}
+
+// hubDBBackupDatabase connects to the database with the values of the database secret
+func hubDBBackupDatabase(th *v1alpha1.TektonHub) common.DBBackupDatabase {
+	secretKeyRef := func(key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: databaseSecretName},
+				Key:                  key,
+			},
+		}
+	}
+	return common.DBBackupDatabase{
+		Name:        defaultPostgresHost,
+		Namespace:   th.Spec.GetTargetNamespace(),
+		OwnerRef:    getOwnerRef(th),
+		Deployments: []string{apiDeploymentName},
+		ConnectionEnv: []corev1.EnvVar{
+			{Name: "PGHOST", ValueFrom: secretKeyRef(secretKeyPostgresHost)},
+			{Name: "PGPORT", ValueFrom: secretKeyRef(secretKeyPostgresPort)},
+			{Name: "PGDATABASE", ValueFrom: secretKeyRef(secretKeyPostgresDB)},
+			{Name: "PGUSER", ValueFrom: secretKeyRef(secretKeyPostgresUser)},
+			{Name: "PGPASSWORD", ValueFrom: secretKeyRef(secretKeyPostgresPassword)},
+		},
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonhub
+
+import (
+	"context"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// names of the Ingresses, same as the OpenShift Routes
+	ingressNameAPI  = "tekton-hub-api"
+	ingressNameAuth = "tekton-hub-auth"
+	ingressNameUI   = "tekton-hub-ui"
+
+	// services and ports exposed by the Ingresses
+	serviceNameAPI  = "tekton-hub-api"
+	serviceNameUI   = "tekton-hub-ui"
+	servicePortAPI  = int32(8000)
+	servicePortAuth = int32(4200)
+	servicePortUI   = int32(8080)
+
+	authBaseURLKey = "AUTH_BASE_URL"
+)
+
+// setIngressURLs sets the api, auth and ui URLs of the status from the ingress hosts,
+// they are written in the ui ConfigMap
+func setIngressURLs(th *v1alpha1.TektonHub) {
+	ingress := th.Spec.Ingress
+	if ingress == nil {
+		return
+	}
+
+	th.Status.SetApiRoute(ingress.Api.URL())
+	authURL := ""
+	if ingress.Auth != nil {
+		authURL = ingress.Auth.URL()
+	}
+	th.Status.SetAuthRoute(authURL)
+	uiURL := ""
+	if ingress.Ui != nil {
+		uiURL = ingress.Ui.URL()
+	}
+	th.Status.SetUiRoute(uiURL)
+}
+
+// updateAuthBaseURL sets the auth URL exposed by the Ingress in the api secret
+func (r *Reconciler) updateAuthBaseURL(ctx context.Context, th *v1alpha1.TektonHub) error {
+	if th.Spec.Ingress == nil || th.Status.AuthRouteUrl == "" {
+		return nil
+	}
+
+	secrets := r.kubeClientSet.CoreV1().Secrets(th.Spec.GetTargetNamespace())
+	secret, err := secrets.Get(ctx, v1alpha1.HubApiSecretName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if string(secret.Data[authBaseURLKey]) == th.Status.AuthRouteUrl {
+		return nil
+	}
+
+	if secret.StringData == nil {
+		secret.StringData = map[string]string{}
+	}
+	secret.StringData[authBaseURLKey] = th.Status.AuthRouteUrl
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// getIngressManifest returns the transformed Ingresses of the api and auth server, or of the ui
+func (r *Reconciler) getIngressManifest(ctx context.Context, th *v1alpha1.TektonHub, ui bool) (*mf.Manifest, error) {
+	manifest, err := ingressManifest(th, ui)
+	if err != nil {
+		return nil, err
+	}
+	return filterAndTransform(r.extension)(ctx, &manifest, th)
+}
+
+// ingressManifest returns the Ingresses of the api and auth server, or of the ui
+func ingressManifest(th *v1alpha1.TektonHub, ui bool) (mf.Manifest, error) {
+	ingress := th.Spec.Ingress
+	if ingress == nil {
+		return mf.Manifest{}, nil
+	}
+
+	var ingresses []*networkingv1.Ingress
+	if ui {
+		if ingress.Ui != nil {
+			ingresses = append(ingresses, makeIngress(ingress, ingressNameUI, *ingress.Ui, serviceNameUI, servicePortUI))
+		}
+	} else {
+		ingresses = append(ingresses, makeIngress(ingress, ingressNameAPI, ingress.Api, serviceNameAPI, servicePortAPI))
+		if ingress.Auth != nil {
+			ingresses = append(ingresses, makeIngress(ingress, ingressNameAuth, *ingress.Auth, serviceNameAPI, servicePortAuth))
+		}
+	}
+
+	resources := []unstructured.Unstructured{}
+	for _, ing := range ingresses {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(ing)
+		if err != nil {
+			return mf.Manifest{}, err
+		}
+		resources = append(resources, unstructured.Unstructured{Object: obj})
+	}
+	return mf.ManifestFrom(mf.Slice(resources))
+}
+
+func makeIngress(spec *v1alpha1.HubIngressSpec, name string, host v1alpha1.HubIngressHost, service string, port int32) *networkingv1.Ingress {
+	pathType := networkingv1.PathTypePrefix
+	ing := &networkingv1.Ingress{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "Ingress",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: spec.Annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: host.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: service,
+									Port: networkingv1.ServiceBackendPort{Number: port},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	if spec.IngressClassName != "" {
+		className := spec.IngressClassName
+		ing.Spec.IngressClassName = &className
+	}
+	if host.TLSSecretName != "" {
+		ing.Spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      []string{host.Host},
+			SecretName: host.TLSSecretName,
+		}}
+	}
+	return ing
+}
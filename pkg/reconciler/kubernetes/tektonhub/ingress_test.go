@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonhub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testHubWithIngress() *v1alpha1.TektonHub {
+	return &v1alpha1.TektonHub{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.HubResourceName},
+		Spec: v1alpha1.TektonHubSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-hub"},
+			Ingress: &v1alpha1.HubIngressSpec{
+				IngressClassName: "nginx",
+				Annotations:      map[string]string{"cert-manager.io/cluster-issuer": "letsencrypt"},
+				Api:              v1alpha1.HubIngressHost{Host: "api.hub.example.com", TLSSecretName: "hub-api-tls"},
+				Auth:             &v1alpha1.HubIngressHost{Host: "auth.hub.example.com", TLSSecretName: "hub-auth-tls"},
+				Ui:               &v1alpha1.HubIngressHost{Host: "hub.example.com"},
+			},
+		},
+	}
+}
+
+func TestSetIngressURLs(t *testing.T) {
+	th := testHubWithIngress()
+	setIngressURLs(th)
+	assert.Equal(t, th.Status.ApiRouteUrl, "https://api.hub.example.com")
+	assert.Equal(t, th.Status.AuthRouteUrl, "https://auth.hub.example.com")
+	assert.Equal(t, th.Status.UiRouteUrl, "http://hub.example.com")
+
+	th.Spec.Ingress.Auth = nil
+	setIngressURLs(th)
+	assert.Equal(t, th.Status.AuthRouteUrl, "")
+
+	// without ingress, the URLs set by the platform are kept
+	th.Spec.Ingress = nil
+	setIngressURLs(th)
+	assert.Equal(t, th.Status.ApiRouteUrl, "https://api.hub.example.com")
+}
+
+func TestIngressManifest(t *testing.T) {
+	th := testHubWithIngress()
+
+	manifest, err := ingressManifest(th, false)
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Resources()), 2)
+
+	api := &networkingv1.Ingress{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, api)
+	assert.NilError(t, err)
+	assert.Equal(t, api.Name, ingressNameAPI)
+	assert.Equal(t, *api.Spec.IngressClassName, "nginx")
+	assert.Equal(t, api.Annotations["cert-manager.io/cluster-issuer"], "letsencrypt")
+	assert.Equal(t, api.Spec.Rules[0].Host, "api.hub.example.com")
+	assert.DeepEqual(t, api.Spec.Rules[0].HTTP.Paths[0].Backend.Service, &networkingv1.IngressServiceBackend{
+		Name: serviceNameAPI,
+		Port: networkingv1.ServiceBackendPort{Number: servicePortAPI},
+	})
+	assert.DeepEqual(t, api.Spec.TLS, []networkingv1.IngressTLS{{Hosts: []string{"api.hub.example.com"}, SecretName: "hub-api-tls"}})
+
+	auth := &networkingv1.Ingress{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[1].Object, auth)
+	assert.NilError(t, err)
+	assert.Equal(t, auth.Name, ingressNameAuth)
+	assert.Equal(t, auth.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Port.Number, servicePortAuth)
+
+	manifest, err = ingressManifest(th, true)
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Resources()), 1)
+	ui := &networkingv1.Ingress{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, ui)
+	assert.NilError(t, err)
+	assert.Equal(t, ui.Name, ingressNameUI)
+	assert.Equal(t, ui.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name, serviceNameUI)
+	assert.Assert(t, ui.Spec.TLS == nil)
+
+	th.Spec.Ingress = nil
+	manifest, err = ingressManifest(th, false)
+	assert.NilError(t, err)
+	assert.Equal(t, len(manifest.Resources()), 0)
+}
+
+func TestUpdateAuthBaseURL(t *testing.T) {
+	ctx := context.TODO()
+	th := testHubWithIngress()
+	setIngressURLs(th)
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.HubApiSecretName, Namespace: "tekton-hub"},
+		Data:       map[string][]byte{"GH_CLIENT_ID": []byte("id")},
+	})
+	r := &Reconciler{kubeClientSet: kubeClient}
+
+	err := r.updateAuthBaseURL(ctx, th)
+	assert.NilError(t, err)
+	secret, err := kubeClient.CoreV1().Secrets("tekton-hub").Get(ctx, v1alpha1.HubApiSecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, secret.StringData[authBaseURLKey], "https://auth.hub.example.com")
+	assert.Equal(t, string(secret.Data["GH_CLIENT_ID"]), "id")
+}
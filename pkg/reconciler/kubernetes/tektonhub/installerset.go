@@ -64,9 +64,9 @@ func (r *Reconciler) checkIfInstallerSetExist(ctx context.Context, oc clientset.
 func createInstallerSet(ctx context.Context, oc clientset.Interface, th *v1alpha1.TektonHub,
 	manifest mf.Manifest, releaseVersion, component, installerSetPrefix, namespace string, labels map[string]string, specHash string) error {
 
-	is := makeInstallerSet(th, manifest, installerSetPrefix, releaseVersion, namespace, labels, specHash)
-	if is == nil {
-		return fmt.Errorf("Unable to create installerset")
+	is, err := makeInstallerSet(th, manifest, installerSetPrefix, releaseVersion, namespace, labels, specHash)
+	if err != nil {
+		return fmt.Errorf("Unable to create installerset: %w", err)
 	}
 
 	createdIs, err := oc.OperatorV1alpha1().TektonInstallerSets().
@@ -85,12 +85,12 @@ func createInstallerSet(ctx context.Context, oc clientset.Interface, th *v1alpha
 	return nil
 }
 
-func makeInstallerSet(th *v1alpha1.TektonHub, manifest mf.Manifest, prefix, releaseVersion, namespace string, labels map[string]string, specHash string) *v1alpha1.TektonInstallerSet {
+func makeInstallerSet(th *v1alpha1.TektonHub, manifest mf.Manifest, prefix, releaseVersion, namespace string, labels map[string]string, specHash string) (*v1alpha1.TektonInstallerSet, error) {
 	ownerRef := *metav1.NewControllerRef(th, th.GetGroupVersionKind())
 
 	tektonHubCRSpecHash, err := hash.Compute(th.Spec)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	is := &v1alpha1.TektonInstallerSet{
@@ -104,14 +104,14 @@ func makeInstallerSet(th *v1alpha1.TektonHub, manifest mf.Manifest, prefix, rele
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			Manifests: manifest.Resources(),
-		},
+	}
+	if err := is.Spec.SetManifests(manifest.Resources()); err != nil {
+		return nil, err
 	}
 
 	if specHash != "" {
 		is.Annotations[v1alpha1.DbSecretHash] = specHash
 	}
 
-	return is
+	return is, nil
 }
@@ -189,6 +189,9 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, th *v1alpha1.TektonHub)
 	}
 	th.Status.MarkPreReconcilerComplete()
 
+	// on Kubernetes, the api, auth and ui are exposed with Ingresses
+	setIngressURLs(th)
+
 	// get TektonHub version and yaml manifests directory
 	version := common.TargetVersion(th)
 	hubManifestDir := filepath.Join(common.ComponentDir(th), version)
@@ -203,6 +206,10 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, th *v1alpha1.TektonHub)
 	}
 	th.Status.MarkDatabaseMigrationDone()
 
+	if err := r.reconcileDBBackup(ctx, th); err != nil {
+		return r.handleError(err, th)
+	}
+
 	if err := r.reconcileApiInstallerSet(ctx, th, hubManifestDir, version); err != nil {
 		return r.handleError(err, th)
 	}
@@ -219,6 +226,9 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, th *v1alpha1.TektonHub)
 	}
 	th.Status.MarkPostReconcilerComplete()
 
+	if th.Spec.Db.Backup != nil {
+		return common.DBBackupStatusRequeue
+	}
 	return nil
 }
 
@@ -244,6 +254,12 @@ func (r *Reconciler) reconcileUiInstallerSet(ctx context.Context, th *v1alpha1.T
 			return err
 		}
 
+		ingressManifest, err := r.getIngressManifest(ctx, th, true)
+		if err != nil {
+			return err
+		}
+		*manifest = manifest.Append(*ingressManifest)
+
 		err = r.setUpAndCreateInstallerSet(ctx, *manifest, th, installerSetNameUI, version, installerSetTypeUI)
 		if err != nil {
 			return err
@@ -305,6 +321,10 @@ func (r *Reconciler) reconcileApiInstallerSet(ctx context.Context, th *v1alpha1.
 
 	th.Status.MarkApiDependenciesInstalled()
 
+	if err := r.updateAuthBaseURL(ctx, th); err != nil {
+		return err
+	}
+
 	exist, err := r.checkIfInstallerSetExist(ctx, r.operatorClientSet, version, installerSetTypeAPI)
 	if err != nil {
 		return err
@@ -326,7 +346,12 @@ func (r *Reconciler) reconcileApiInstallerSet(ctx context.Context, th *v1alpha1.
 			return err
 		}
 
-		*manifest = manifest.Append(*infoManifest)
+		ingressManifest, err := r.getIngressManifest(ctx, th, false)
+		if err != nil {
+			return err
+		}
+
+		*manifest = manifest.Append(*infoManifest, *ingressManifest)
 
 		err = applyPVC(ctx, manifest, th)
 		if err != nil {
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	mfDynamic "github.com/manifestival/client-go-client/pkg/dynamic"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// SetServerSideApply moves the installer to server-side apply under the operator field manager,
+// the conflict policy decides if the fields owned by other managers are taken over or reported
+func (i *installer) SetServerSideApply(resourceGetter mfDynamic.ResourceGetter, conflictPolicy string) {
+	i.resourceGetter = resourceGetter
+	i.conflictPolicy = conflictPolicy
+}
+
+// ConflictedResources returns the resources which were not applied as some of
+// their fields are owned by other field managers
+func (i *installer) ConflictedResources() []v1alpha1.ConflictedResource {
+	return i.conflicted
+}
+
+func (i *installer) serverSideApplyEnabled() bool {
+	return i.resourceGetter != nil
+}
+
+// serverSideApply applies the expected resource under the operator field manager. A conflict
+// is reported, and the resource left unchanged, with the Report conflict policy
+func (i *installer) serverSideApply(ctx context.Context, expected *unstructured.Unstructured) error {
+	resource, err := i.resourceGetter.ResourceInterface(expected)
+	if err != nil {
+		return err
+	}
+
+	force := i.conflictPolicy != v1alpha1.ConflictPolicyReport
+	_, err = resource.Apply(ctx, expected.GetName(), expected, metav1.ApplyOptions{
+		FieldManager: v1alpha1.FieldManager,
+		Force:        force,
+	})
+	if err != nil && !force && apierrs.IsConflict(err) {
+		i.recordConflict(expected, err)
+		return nil
+	}
+	return err
+}
+
+// recordConflict records the fields and managers of an apply conflict
+func (i *installer) recordConflict(expected *unstructured.Unstructured, err error) {
+	conflicted := v1alpha1.ConflictedResource{
+		APIVersion: expected.GetAPIVersion(),
+		Kind:       expected.GetKind(),
+		Namespace:  expected.GetNamespace(),
+		Name:       expected.GetName(),
+	}
+
+	var status apierrs.APIStatus
+	if errors.As(err, &status) && status.Status().Details != nil {
+		for _, cause := range status.Status().Details.Causes {
+			if cause.Type != metav1.CauseTypeFieldManagerConflict {
+				continue
+			}
+			conflicted.Fields = append(conflicted.Fields, strings.TrimPrefix(cause.Field, "."))
+			// the message is: conflict with "<manager>" [with subresource "<subresource>"] using <apiVersion>
+			if manager := strings.Split(cause.Message, "\""); len(manager) > 2 && !slices.Contains(conflicted.Managers, manager[1]) {
+				conflicted.Managers = append(conflicted.Managers, manager[1])
+			}
+		}
+	}
+	i.conflicted = append(i.conflicted, conflicted)
+
+	i.logger.Infow("resource not applied, fields are owned by other field managers",
+		"kind", conflicted.Kind,
+		"namespace", conflicted.Namespace,
+		"name", conflicted.Name,
+		"fields", conflicted.Fields,
+		"managers", conflicted.Managers,
+	)
+}
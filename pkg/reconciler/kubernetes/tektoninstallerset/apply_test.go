@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/manifestival/manifestival/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+type appliedResource struct {
+	obj     *unstructured.Unstructured
+	options metav1.ApplyOptions
+}
+
+// fakeApplier records the server-side applies, it only implements Apply
+type fakeApplier struct {
+	dynamic.NamespaceableResourceInterface
+	applied []appliedResource
+	err     error
+}
+
+func (f *fakeApplier) ResourceInterface(_ *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	return f, nil
+}
+
+func (f *fakeApplier) Apply(_ context.Context, _ string, obj *unstructured.Unstructured, options metav1.ApplyOptions, _ ...string) (*unstructured.Unstructured, error) {
+	f.applied = append(f.applied, appliedResource{obj: obj.DeepCopy(), options: options})
+	return obj, f.err
+}
+
+func newSSAInstaller(t *testing.T, applier *fakeApplier, conflictPolicy string, k8sClient *k8sfake.Clientset, resources ...unstructured.Unstructured) *installer {
+	t.Helper()
+	manifest, err := mf.ManifestFrom(mf.Slice(resources))
+	assert.NilError(t, err)
+	i := NewInstaller(&manifest, fake.New(), k8sClient, zap.NewNop().Sugar())
+	i.SetServerSideApply(applier, conflictPolicy)
+	return i
+}
+
+func TestServerSideApply_Force(t *testing.T) {
+	applier := &fakeApplier{}
+	i := newSSAInstaller(t, applier, v1alpha1.ConflictPolicyForce, k8sfake.NewSimpleClientset(), serviceAccount)
+
+	assert.NilError(t, i.EnsureNamespaceScopedResources())
+
+	assert.Equal(t, len(applier.applied), 1)
+	assert.Equal(t, applier.applied[0].obj.GetName(), serviceAccount.GetName())
+	assert.Assert(t, applier.applied[0].obj.GetAnnotations()[v1alpha1.LastAppliedHashKey] != "")
+	assert.DeepEqual(t, applier.applied[0].options, metav1.ApplyOptions{FieldManager: v1alpha1.FieldManager, Force: true})
+	assert.Equal(t, len(i.ConflictedResources()), 0)
+}
+
+func TestServerSideApply_ReportConflict(t *testing.T) {
+	applier := &fakeApplier{err: apierrs.NewApplyConflict([]metav1.StatusCause{
+		{Type: metav1.CauseTypeFieldManagerConflict, Field: ".metadata.labels.team", Message: `conflict with "kubectl-edit" using v1`},
+		{Type: metav1.CauseTypeFieldManagerConflict, Field: ".secrets", Message: `conflict with "kubectl-edit" using v1`},
+	}, "Apply failed with 2 conflicts")}
+	i := newSSAInstaller(t, applier, v1alpha1.ConflictPolicyReport, k8sfake.NewSimpleClientset(), serviceAccount)
+
+	assert.NilError(t, i.EnsureNamespaceScopedResources())
+
+	assert.Equal(t, applier.applied[0].options.Force, false)
+	assert.DeepEqual(t, i.ConflictedResources(), []v1alpha1.ConflictedResource{{
+		APIVersion: "v1",
+		Kind:       "ServiceAccount",
+		Namespace:  "test",
+		Name:       "test-service-account",
+		Fields:     []string{"metadata.labels.team", "secrets"},
+		Managers:   []string{"kubectl-edit"},
+	}})
+
+	// other errors are returned
+	applier.err = apierrs.NewForbidden(schema.GroupResource{Resource: "serviceaccounts"}, "test-service-account", nil)
+	assert.Assert(t, apierrs.IsForbidden(i.EnsureNamespaceScopedResources()))
+}
+
+func TestServerSideApply_DeploymentWithHPA(t *testing.T) {
+	deployment := namespacedResource("apps/v1", "Deployment", "test", "controller")
+	assert.NilError(t, unstructured.SetNestedField(deployment.Object, int64(2), "spec", "replicas"))
+	k8sClient := k8sfake.NewSimpleClientset(&autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "controller", Namespace: "test"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "controller"},
+			MaxReplicas:    5,
+		},
+	})
+	applier := &fakeApplier{}
+	i := newSSAInstaller(t, applier, v1alpha1.ConflictPolicyForce, k8sClient, deployment)
+
+	assert.NilError(t, i.EnsureDeploymentResources(context.TODO()))
+
+	// the replicas are owned by the HPA
+	assert.Equal(t, len(applier.applied), 1)
+	_, found, _ := unstructured.NestedInt64(applier.applied[0].obj.Object, "spec", "replicas")
+	assert.Assert(t, !found)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
+	autoscalingv2listers "k8s.io/client-go/listers/autoscaling/v2"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	rbacv1listers "k8s.io/client-go/listers/rbac/v1"
+	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
+	statefulsetinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/statefulset"
+	hpainformer "knative.dev/pkg/client/injection/kube/informers/autoscaling/v2/horizontalpodautoscaler"
+	serviceAccountInformer "knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount"
+	clusterRoleInformer "knative.dev/pkg/client/injection/kube/informers/rbac/v1/clusterrole"
+	clusterRoleBindingInformer "knative.dev/pkg/client/injection/kube/informers/rbac/v1/clusterrolebinding"
+)
+
+// resourceCache reads the kinds watched by the controller from the shared informers
+// instead of the API server. The cache can lag behind the cluster, a stale read ends
+// in a conflict or an already exists error and the installer set is reconciled again
+type resourceCache struct {
+	deployments         appsv1listers.DeploymentLister
+	statefulSets        appsv1listers.StatefulSetLister
+	hpas                autoscalingv2listers.HorizontalPodAutoscalerLister
+	serviceAccounts     corev1listers.ServiceAccountLister
+	clusterRoles        rbacv1listers.ClusterRoleLister
+	clusterRoleBindings rbacv1listers.ClusterRoleBindingLister
+}
+
+func newResourceCache(ctx context.Context) *resourceCache {
+	return &resourceCache{
+		deployments:         deploymentinformer.Get(ctx).Lister(),
+		statefulSets:        statefulsetinformer.Get(ctx).Lister(),
+		hpas:                hpainformer.Get(ctx).Lister(),
+		serviceAccounts:     serviceAccountInformer.Get(ctx).Lister(),
+		clusterRoles:        clusterRoleInformer.Get(ctx).Lister(),
+		clusterRoleBindings: clusterRoleBindingInformer.Get(ctx).Lister(),
+	}
+}
+
+// get returns the cached resource, cached is false if the kind of the resource is not cached
+func (c *resourceCache) get(u *unstructured.Unstructured) (resource *unstructured.Unstructured, cached bool, err error) {
+	var obj runtime.Object
+	switch u.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Group: "apps", Kind: "Deployment"}:
+		obj, err = c.deployments.Deployments(u.GetNamespace()).Get(u.GetName())
+	case schema.GroupKind{Group: "apps", Kind: "StatefulSet"}:
+		obj, err = c.statefulSets.StatefulSets(u.GetNamespace()).Get(u.GetName())
+	case schema.GroupKind{Kind: "ServiceAccount"}:
+		obj, err = c.serviceAccounts.ServiceAccounts(u.GetNamespace()).Get(u.GetName())
+	case schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:
+		obj, err = c.clusterRoles.Get(u.GetName())
+	case schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}:
+		obj, err = c.clusterRoleBindings.Get(u.GetName())
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, true, err
+	}
+
+	// the informers share the cached objects, never hand them out
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.DeepCopyObject())
+	if err != nil {
+		return nil, true, err
+	}
+	resource = &unstructured.Unstructured{Object: content}
+	// the typed objects in the cache have no type meta
+	resource.SetAPIVersion(u.GetAPIVersion())
+	resource.SetKind(u.GetKind())
+	return resource, true, nil
+}
+
+// SetResourceCache makes the installer read the cached kinds from the shared informers
+func (i *installer) SetResourceCache(cache *resourceCache) {
+	i.cache = cache
+}
+
+// get reads the resource from the informer cache if its kind is cached, from the API server otherwise
+func (i *installer) get(u *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if i.cache != nil {
+		if resource, cached, err := i.cache.get(u); cached {
+			return resource, err
+		}
+	}
+	return i.mfClient.Get(u)
+}
+
+// listHPAs lists the HorizontalPodAutoscalers of a namespace
+func (i *installer) listHPAs(ctx context.Context, namespace string) ([]autoscalingv2.HorizontalPodAutoscaler, error) {
+	if i.cache != nil {
+		cached, err := i.cache.hpas.HorizontalPodAutoscalers(namespace).List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		hpas := make([]autoscalingv2.HorizontalPodAutoscaler, 0, len(cached))
+		for _, hpa := range cached {
+			hpas = append(hpas, *hpa.DeepCopy())
+		}
+		return hpas, nil
+	}
+
+	hpaList, err := i.kubeClientSet.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return hpaList.Items, nil
+}
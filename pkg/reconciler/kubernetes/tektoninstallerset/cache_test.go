@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/manifestival/manifestival/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+// countingClient counts the reads sent to the API server
+type countingClient struct {
+	mf.Client
+	gets int
+}
+
+func (c *countingClient) Get(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	c.gets++
+	return c.Client.Get(obj)
+}
+
+// newTestResourceCache returns a cache backed by informers holding the given objects
+func newTestResourceCache(t *testing.T, objs ...runtime.Object) *resourceCache {
+	t.Helper()
+	factory := informers.NewSharedInformerFactory(k8sfake.NewSimpleClientset(), 0)
+	for _, obj := range objs {
+		var err error
+		switch obj.(type) {
+		case *appsv1.Deployment:
+			err = factory.Apps().V1().Deployments().Informer().GetIndexer().Add(obj)
+		case *appsv1.StatefulSet:
+			err = factory.Apps().V1().StatefulSets().Informer().GetIndexer().Add(obj)
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			err = factory.Autoscaling().V2().HorizontalPodAutoscalers().Informer().GetIndexer().Add(obj)
+		case *corev1.ServiceAccount:
+			err = factory.Core().V1().ServiceAccounts().Informer().GetIndexer().Add(obj)
+		default:
+			t.Fatalf("unexpected cached object %T", obj)
+		}
+		assert.NilError(t, err)
+	}
+	return &resourceCache{
+		deployments:         factory.Apps().V1().Deployments().Lister(),
+		statefulSets:        factory.Apps().V1().StatefulSets().Lister(),
+		hpas:                factory.Autoscaling().V2().HorizontalPodAutoscalers().Lister(),
+		serviceAccounts:     factory.Core().V1().ServiceAccounts().Lister(),
+		clusterRoles:        factory.Rbac().V1().ClusterRoles().Lister(),
+		clusterRoleBindings: factory.Rbac().V1().ClusterRoleBindings().Lister(),
+	}
+}
+
+// toTyped converts a resource read from the cluster to the object kept by an informer
+func toTyped(t *testing.T, client mf.Client, u unstructured.Unstructured, obj runtime.Object) runtime.Object {
+	t.Helper()
+	existing, err := client.Get(&u)
+	assert.NilError(t, err)
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(existing.Object, obj))
+	return obj
+}
+
+func hpaLists(k8sClient *k8sfake.Clientset) int {
+	count := 0
+	for _, action := range k8sClient.Actions() {
+		if action.GetVerb() == "list" && action.GetResource().Resource == "horizontalpodautoscalers" {
+			count++
+		}
+	}
+	return count
+}
+
+func TestResourceCache_APICallsPerReconcile(t *testing.T) {
+	configMap := namespacedResource("v1", "ConfigMap", "test", "config")
+	deployment := namespacedResource("apps/v1", "Deployment", "test", "tekton-controller")
+	resources := []unstructured.Unstructured{serviceAccount, configMap, deployment}
+
+	store := fake.New()
+	reconcile := func(cache *resourceCache) (gets, lists int) {
+		client := &countingClient{Client: store}
+		k8sClient := k8sfake.NewSimpleClientset()
+		manifest, err := mf.ManifestFrom(mf.Slice(resources))
+		assert.NilError(t, err)
+		i := NewInstaller(&manifest, client, k8sClient, zap.NewNop().Sugar())
+		if cache != nil {
+			i.SetResourceCache(cache)
+		}
+
+		assert.NilError(t, i.EnsureNamespaceScopedResources())
+		assert.NilError(t, i.EnsureDeploymentResources(context.TODO()))
+		assert.NilError(t, i.IsControllerReady())
+		return client.gets, hpaLists(k8sClient)
+	}
+
+	// install the resources and make the deployment available
+	manifest, err := mf.ManifestFrom(mf.Slice(resources))
+	assert.NilError(t, err)
+	i := NewInstaller(&manifest, store, k8sfake.NewSimpleClientset(), zap.NewNop().Sugar())
+	assert.NilError(t, i.EnsureNamespaceScopedResources())
+	assert.NilError(t, i.EnsureDeploymentResources(context.TODO()))
+	installed, err := store.Get(&deployment)
+	assert.NilError(t, err)
+	assert.NilError(t, unstructured.SetNestedSlice(installed.Object, []interface{}{
+		map[string]interface{}{"type": string(appsv1.DeploymentAvailable), "status": string(corev1.ConditionTrue)},
+	}, "status", "conditions"))
+
+	// every resource is read from the API server, and the HPAs listed for each deployment
+	gets, lists := reconcile(nil)
+	assert.Equal(t, gets, 4)
+	assert.Equal(t, lists, 1)
+
+	// only the kinds without an informer are read from the API server
+	cache := newTestResourceCache(t,
+		toTyped(t, store, serviceAccount, &corev1.ServiceAccount{}),
+		toTyped(t, store, deployment, &appsv1.Deployment{}),
+	)
+	gets, lists = reconcile(cache)
+	assert.Equal(t, gets, 1)
+	assert.Equal(t, lists, 0)
+}
+
+func TestResourceCache_Get(t *testing.T) {
+	cache := newTestResourceCache(t,
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "test-service-account", Namespace: "test", Labels: map[string]string{"app": "test"}}},
+		&autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "controller", Namespace: "test"}},
+	)
+	i := NewInstaller(&mf.Manifest{}, fake.New(), k8sfake.NewSimpleClientset(), zap.NewNop().Sugar())
+	i.SetResourceCache(cache)
+
+	res, err := i.get(&serviceAccount)
+	assert.NilError(t, err)
+	assert.Equal(t, res.GetAPIVersion(), "v1")
+	assert.Equal(t, res.GetKind(), "ServiceAccount")
+	assert.DeepEqual(t, res.GetLabels(), map[string]string{"app": "test"})
+
+	// the cached objects are not shared
+	res.SetLabels(nil)
+	res, err = i.get(&serviceAccount)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res.GetLabels(), map[string]string{"app": "test"})
+
+	_, err = i.get(&unstructured.Unstructured{Object: namespacedResource("apps/v1", "Deployment", "test", "missing").Object})
+	assert.Assert(t, apierrs.IsNotFound(err))
+
+	// kinds without an informer are read from the API server
+	_, err = i.get(&unstructured.Unstructured{Object: namespacedResource("v1", "ConfigMap", "test", "config").Object})
+	assert.Assert(t, apierrs.IsNotFound(err))
+
+	hpas, err := i.listHPAs(context.TODO(), "test")
+	assert.NilError(t, err)
+	assert.Equal(t, len(hpas), 1)
+}
+
+func TestEnsureResources_CreatedSinceCacheSync(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{serviceAccount}))
+	assert.NilError(t, err)
+	client := fake.New()
+	client.Stubs.Create = func(u *unstructured.Unstructured) error {
+		return apierrs.NewAlreadyExists(corev1.Resource("serviceaccounts"), u.GetName())
+	}
+	i := NewInstaller(&manifest, client, k8sfake.NewSimpleClientset(), zap.NewNop().Sugar())
+	i.SetResourceCache(newTestResourceCache(t))
+
+	assert.Equal(t, i.EnsureNamespaceScopedResources(), v1alpha1.RECONCILE_AGAIN_ERR)
+}
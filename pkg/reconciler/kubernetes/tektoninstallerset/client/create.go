@@ -144,7 +144,7 @@ func (i *InstallerSetClient) makeInstallerSet(ctx context.Context, comp v1alpha1
 	}
 
 	ownerRef := *metav1.NewControllerRef(comp, v1alpha1.SchemeGroupVersion.WithKind(i.resourceKind))
-	return &v1alpha1.TektonInstallerSet{
+	is := &v1alpha1.TektonInstallerSet{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: isName,
 			Labels:       labels,
@@ -154,10 +154,12 @@ func (i *InstallerSetClient) makeInstallerSet(ctx context.Context, comp v1alpha1
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			Manifests: manifest.Resources(),
-		},
-	}, nil
+	}
+	// large manifests, such as the CRDs, are stored compressed
+	if err := is.Spec.SetManifests(manifest.Resources()); err != nil {
+		return nil, err
+	}
+	return is, nil
 }
 
 func (i *InstallerSetClient) getDefaultLabels(isType string) map[string]string {
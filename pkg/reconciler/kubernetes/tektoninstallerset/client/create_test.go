@@ -129,3 +129,28 @@ func TestInstallerSetClient_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestInstallerSetClient_CreateCompressed(t *testing.T) {
+	ctx, _ := testing2.SetupFakeContext(t)
+	comp := &v1alpha1.TektonTrigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "trigger"},
+		Spec: v1alpha1.TektonTriggerSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "test"},
+		},
+	}
+	crd := namespacedResource("apiextensions.k8s.io/v1", "CustomResourceDefinition", "", "pipelines.tekton.dev")
+	assert.NilError(t, unstructured.SetNestedField(crd.Object, strings.Repeat("x", v1alpha1.InstallerSetCompressThreshold), "spec", "description"))
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{crd, serviceAccount}))
+	assert.NilError(t, err)
+
+	tisClient := fake.NewSimpleClientset().OperatorV1alpha1().TektonInstallerSets()
+	client := NewInstallerSetClient(tisClient, "devel", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+	iSs, err := client.create(ctx, comp, &manifest, InstallerTypePre, nil)
+	assert.NilError(t, err)
+
+	assert.Assert(t, len(iSs[0].Spec.Manifests) == 0)
+	assert.Assert(t, len(iSs[0].Spec.CompressedManifests) != 0)
+	resources, err := iSs[0].Spec.GetManifests()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []unstructured.Unstructured(resources), manifest.Resources())
+}
@@ -22,6 +22,10 @@ import (
 
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
 	"knative.dev/pkg/logging"
 )
 
@@ -38,6 +42,64 @@ func (i *InstallerSetClient) CustomSet(ctx context.Context, comp v1alpha1.Tekton
 	return i.applyTransformationAndCreateSet(ctx, comp, setType, manifest, filterAndTransform, customLabels)
 }
 
+// UpdateCustomSet updates the manifests and the custom labels of an existing custom set in place, for changes
+// of its content which are not part of the spec of the component. The resources stay on the cluster during the
+// update, unlike with a cleanup of the set. It returns the resources which are no longer part of the set
+func (i *InstallerSetClient) UpdateCustomSet(ctx context.Context, comp v1alpha1.TektonComponent, set v1alpha1.TektonInstallerSet, manifest *mf.Manifest, filterAndTransform FilterAndTransform, customLabels map[string]string) ([]unstructured.Unstructured, error) {
+	manifestUpdated, err := filterAndTransform(ctx, manifest, comp)
+	if err != nil {
+		return nil, err
+	}
+	specHash, err := hash.Compute(comp.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []unstructured.Unstructured
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		onCluster, err := i.clientSet.Get(ctx, set.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		resources, err := onCluster.Spec.GetManifests()
+		if err != nil {
+			return err
+		}
+		previous, err := mf.ManifestFrom(resources)
+		if err != nil {
+			return err
+		}
+
+		labels := onCluster.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		for key, value := range customLabels {
+			labels[key] = value
+		}
+		onCluster.SetLabels(labels)
+		annotations := onCluster.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[v1alpha1.LastAppliedHashKey] = specHash
+		onCluster.SetAnnotations(annotations)
+		if err := onCluster.Spec.SetManifests(manifestUpdated.Resources()); err != nil {
+			return err
+		}
+		if _, err := i.clientSet.Update(ctx, onCluster, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+
+		removed = previous.Filter(mf.Not(mf.In(*manifestUpdated))).Resources()
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+	return removed, nil
+}
+
 func (i *InstallerSetClient) applyTransformationAndCreateSet(ctx context.Context, comp v1alpha1.TektonComponent, setType string, manifest *mf.Manifest, filterAndTransform FilterAndTransform, customLabels map[string]string) error {
 	// perform transformation
 	manifestUpdated, err := filterAndTransform(ctx, manifest, comp)
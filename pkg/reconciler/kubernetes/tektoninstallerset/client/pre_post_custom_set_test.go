@@ -132,3 +132,33 @@ func TestInstallerSetClient_PreSet_Upgrade_Update(t *testing.T) {
 	assert.Assert(t, len(list.Items) != 0)
 	assert.Equal(t, len(list.Items[0].Spec.Manifests), 2)
 }
+
+func TestInstallerSetClient_UpdateCustomSet(t *testing.T) {
+	ctx, _ := testing2.SetupFakeContext(t)
+	existingIS := &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "trigger-custom-catalog-test",
+			Labels: map[string]string{"digest": "old"},
+		},
+		Spec: v1alpha1.TektonInstallerSetSpec{
+			Manifests: []unstructured.Unstructured{serviceAccount, deployment},
+		},
+	}
+	fakeClient := fake2.NewFakeISClient(existingIS)
+	client := NewInstallerSetClient(fakeClient, "releaseVersion", "test-version", v1alpha1.KindTektonTrigger, &testMetrics{})
+
+	// the content changed, the service account is dropped
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{deployment}))
+	assert.NilError(t, err)
+	removed, err := client.UpdateCustomSet(ctx, comp, *existingIS, &manifest, filterAndTransform(nil), map[string]string{"digest": "new"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(removed), 1)
+	assert.Equal(t, removed[0].GetKind(), serviceAccount.GetKind())
+
+	// the set is updated in place
+	updated, err := fakeClient.Get(ctx, existingIS.GetName(), metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, updated.GetLabels()["digest"], "new")
+	assert.Equal(t, len(updated.Spec.Manifests), 1)
+	assert.Equal(t, updated.Spec.Manifests[0].GetKind(), deployment.GetKind())
+}
@@ -97,7 +97,9 @@ func (i *InstallerSetClient) updateSet(ctx context.Context, comp v1alpha1.Tekton
 		current[v1alpha1.LastAppliedHashKey] = specHash
 		onCluster.SetAnnotations(current)
 
-		onCluster.Spec.Manifests = manifest.Resources()
+		if err := onCluster.Spec.SetManifests(manifest.Resources()); err != nil {
+			return err
+		}
 
 		updatedSet, err = i.clientSet.Update(ctx, onCluster, metav1.UpdateOptions{})
 		if err != nil {
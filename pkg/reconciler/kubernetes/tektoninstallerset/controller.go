@@ -20,11 +20,13 @@ import (
 	"context"
 
 	mfc "github.com/manifestival/client-go-client"
+	mfDynamic "github.com/manifestival/client-go-client/pkg/dynamic"
 	"go.uber.org/zap"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	tektonConfiginformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonconfig"
 	tektonInstallerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektoninstallerset"
 	tektonInstallerReconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektoninstallerset"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
@@ -55,10 +57,18 @@ func NewExtendedController() injection.ControllerConstructor {
 			logger.Fatalw("Error creating client from injected config", zap.Error(err))
 		}
 
+		resourceGetter, err := mfDynamic.NewForConfig(injection.GetConfig(ctx))
+		if err != nil {
+			logger.Fatalw("Error creating resource getter from injected config", zap.Error(err))
+		}
+
 		c := &Reconciler{
-			operatorClientSet: operatorclient.Get(ctx),
-			mfClient:          mfclient,
-			kubeClientSet:     kubeclient.Get(ctx),
+			operatorClientSet:  operatorclient.Get(ctx),
+			mfClient:           mfclient,
+			resourceGetter:     resourceGetter,
+			resourceCache:      newResourceCache(ctx),
+			kubeClientSet:      kubeclient.Get(ctx),
+			tektonConfigLister: tektonConfiginformer.Get(ctx).Lister(),
 		}
 		impl := tektonInstallerReconciler.NewImpl(ctx, c)
 
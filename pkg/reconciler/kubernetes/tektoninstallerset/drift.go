@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// SetDriftDetection configures how the installer handles the changes done on the
+// resources outside of the operator. specApplied has to be true only if the current
+// manifests were applied successfully before, otherwise a difference on the cluster
+// is an expected change from the manifests and not a drift
+func (i *installer) SetDriftDetection(policy string, specApplied bool) {
+	i.driftPolicy = policy
+	i.specApplied = specApplied
+}
+
+// DriftedResources returns the resources which were found changed on the cluster
+func (i *installer) DriftedResources() []v1alpha1.DriftedResource {
+	return i.drifted
+}
+
+// recordDrift records the existing resource as drifted and returns true
+// if the installer should not revert the drift
+func (i *installer) recordDrift(existing *unstructured.Unstructured, fields []string) bool {
+	drifted := v1alpha1.DriftedResource{
+		APIVersion:    existing.GetAPIVersion(),
+		Kind:          existing.GetKind(),
+		Namespace:     existing.GetNamespace(),
+		Name:          existing.GetName(),
+		Fields:        fields,
+		LastChangedBy: lastChangedBy(existing),
+	}
+	i.drifted = append(i.drifted, drifted)
+
+	i.logger.Infow("resource changed outside of the operator",
+		"kind", drifted.Kind,
+		"namespace", drifted.Namespace,
+		"name", drifted.Name,
+		"fields", drifted.Fields,
+		"lastChangedBy", drifted.LastChangedBy,
+		"driftPolicy", i.driftPolicy,
+	)
+	return i.driftPolicy == v1alpha1.DriftPolicyReportOnly
+}
+
+// objectDriftedFields returns the fields of the existing resource which differ from
+// the expected resource. If no fields are specified, the complete object except
+// the metadata, apart from labels and annotations, is compared
+func objectDriftedFields(expected, existing *unstructured.Unstructured, fieldKeys ...string) []string {
+	if len(fieldKeys) == 0 {
+		fieldKeys = []string{annotationsPath, labelsPath}
+		for key := range expected.Object {
+			switch key {
+			case "apiVersion", "kind", "metadata", "status":
+				continue
+			}
+			fieldKeys = append(fieldKeys, key)
+		}
+		sort.Strings(fieldKeys)
+	}
+
+	fields := []string{}
+	for _, fieldKey := range fieldKeys {
+		nestedKeys := strings.Split(fieldKey, ".")
+		expectedValue, _, _ := unstructured.NestedFieldNoCopy(expected.Object, nestedKeys...)
+		existingValue, _, _ := unstructured.NestedFieldNoCopy(existing.Object, nestedKeys...)
+		fields = append(fields, driftedFields(expectedValue, existingValue, fieldKey)...)
+	}
+	return fields
+}
+
+// driftedFields compares the expected value with the existing value and returns the
+// paths which differ. Fields present only in the existing value, like the ones defaulted
+// by the API server or added by other controllers, are not considered as drift
+func driftedFields(expected, existing interface{}, path string) []string {
+	// nothing is expected or the expected empty value is omitted on the existing resource
+	if expected == nil || (isEmptyValue(expected) && isEmptyValue(existing)) {
+		return nil
+	}
+
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		existingValue, ok := existing.(map[string]interface{})
+		if !ok && len(expectedValue) > 0 {
+			return []string{path}
+		}
+		keys := make([]string, 0, len(expectedValue))
+		for key := range expectedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		fields := []string{}
+		for _, key := range keys {
+			fields = append(fields, driftedFields(expectedValue[key], existingValue[key], fmt.Sprintf("%s.%s", path, key))...)
+		}
+		return fields
+
+	case []interface{}:
+		existingValue, ok := existing.([]interface{})
+		if !ok || len(existingValue) != len(expectedValue) {
+			return []string{path}
+		}
+		fields := []string{}
+		for index := range expectedValue {
+			fields = append(fields, driftedFields(expectedValue[index], existingValue[index], fmt.Sprintf("%s[%d]", path, index))...)
+		}
+		return fields
+
+	default:
+		if reflect.DeepEqual(expected, existing) {
+			return nil
+		}
+		// numbers can be decoded as int64 or float64, compare their representation
+		if existing != nil && fmt.Sprint(expected) == fmt.Sprint(existing) {
+			return nil
+		}
+		return []string{path}
+	}
+}
+
+// isEmptyValue returns true for the values which are omitted when the
+// resource is serialized, hence can be missing on the existing resource
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int64:
+		return v == 0
+	case float64:
+		return v == 0
+	}
+	return false
+}
+
+// lastChangedBy returns the field manager who updated the resource most recently
+func lastChangedBy(u *unstructured.Unstructured) string {
+	var latest *metav1.Time
+	manager := ""
+	for _, entry := range u.GetManagedFields() {
+		if entry.Time == nil {
+			continue
+		}
+		if latest == nil || entry.Time.After(latest.Time) {
+			latest = entry.Time
+			manager = entry.Manager
+		}
+	}
+	return manager
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektoninstallerset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/manifestival/manifestival/fake"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+)
+
+func TestDriftedFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected interface{}
+		existing interface{}
+		want     []string
+	}{
+		{
+			name:     "same values",
+			expected: map[string]interface{}{"a": "b", "c": int64(1)},
+			existing: map[string]interface{}{"a": "b", "c": int64(1)},
+			want:     []string{},
+		},
+		{
+			name:     "extra fields on existing are not drift",
+			expected: map[string]interface{}{"a": "b"},
+			existing: map[string]interface{}{"a": "b", "defaulted": "value"},
+			want:     []string{},
+		},
+		{
+			name:     "changed value",
+			expected: map[string]interface{}{"a": "b", "c": "d"},
+			existing: map[string]interface{}{"a": "changed", "c": "d"},
+			want:     []string{"data.a"},
+		},
+		{
+			name:     "removed value",
+			expected: map[string]interface{}{"a": "b"},
+			existing: map[string]interface{}{},
+			want:     []string{"data.a"},
+		},
+		{
+			name:     "empty value omitted on existing",
+			expected: map[string]interface{}{"a": "", "b": false, "c": map[string]interface{}{}},
+			existing: map[string]interface{}{},
+			want:     []string{},
+		},
+		{
+			name:     "numbers with different types",
+			expected: map[string]interface{}{"a": int64(2)},
+			existing: map[string]interface{}{"a": float64(2)},
+			want:     []string{},
+		},
+		{
+			name:     "list item changed",
+			expected: map[string]interface{}{"a": []interface{}{map[string]interface{}{"name": "x", "image": "foo"}}},
+			existing: map[string]interface{}{"a": []interface{}{map[string]interface{}{"name": "x", "image": "bar", "defaulted": "value"}}},
+			want:     []string{"data.a[0].image"},
+		},
+		{
+			name:     "list length changed",
+			expected: map[string]interface{}{"a": []interface{}{"x"}},
+			existing: map[string]interface{}{"a": []interface{}{"x", "y"}},
+			want:     []string{"data.a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := driftedFields(test.expected, test.existing, "data")
+			if len(test.want) == 0 {
+				assert.Equal(t, len(got), 0)
+				return
+			}
+			assert.DeepEqual(t, got, test.want)
+		})
+	}
+}
+
+func TestLastChangedBy(t *testing.T) {
+	u := &unstructured.Unstructured{}
+	now := time.Now()
+	u.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "operator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: now.Add(-time.Hour)}},
+		{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: now}},
+		{Manager: "no-time", Operation: metav1.ManagedFieldsOperationUpdate},
+	})
+	assert.Equal(t, lastChangedBy(u), "kubectl-edit")
+	assert.Equal(t, lastChangedBy(&unstructured.Unstructured{}), "")
+}
+
+func TestEnsureResources_DriftDetected(t *testing.T) {
+	configMap := namespacedResource("v1", "ConfigMap", "test", "config-defaults")
+	configMap.Object["data"] = map[string]interface{}{"default-timeout-minutes": "60"}
+	expectedHash, err := hash.Compute(configMap.Object)
+	assert.NilError(t, err)
+
+	// config map edited on the cluster after the operator applied it
+	edited := configMap.DeepCopy()
+	edited.Object["data"] = map[string]interface{}{"default-timeout-minutes": "120"}
+	edited.SetAnnotations(map[string]string{v1alpha1.LastAppliedHashKey: expectedHash})
+
+	fakeClient := fake.New(edited)
+	logger, err := zap.NewDevelopment()
+	assert.NilError(t, err)
+
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{configMap}))
+	assert.NilError(t, err)
+
+	i := NewInstaller(&manifest, fakeClient, k8sfake.NewSimpleClientset(), logger.Sugar())
+	i.SetDriftDetection(v1alpha1.DriftPolicyEnforce, true)
+
+	err = i.EnsureNamespaceScopedResources()
+	assert.NilError(t, err)
+
+	drifted := i.DriftedResources()
+	assert.Equal(t, len(drifted), 1)
+	assert.Equal(t, drifted[0].Name, "config-defaults")
+	assert.DeepEqual(t, drifted[0].Fields, []string{"data.default-timeout-minutes"})
+
+	// enforce reverts the drift
+	existing, err := fakeClient.Get(&configMap)
+	assert.NilError(t, err)
+	value, _, err := unstructured.NestedString(existing.Object, "data", "default-timeout-minutes")
+	assert.NilError(t, err)
+	assert.Equal(t, value, "60")
+}
+
+func TestEnsureResources_DriftReportOnly(t *testing.T) {
+	configMap := namespacedResource("v1", "ConfigMap", "test", "config-defaults")
+	configMap.Object["data"] = map[string]interface{}{"default-timeout-minutes": "60"}
+	expectedHash, err := hash.Compute(configMap.Object)
+	assert.NilError(t, err)
+
+	edited := configMap.DeepCopy()
+	edited.Object["data"] = map[string]interface{}{"default-timeout-minutes": "120"}
+	edited.SetAnnotations(map[string]string{v1alpha1.LastAppliedHashKey: expectedHash})
+
+	fakeClient := fake.New(edited)
+	logger, err := zap.NewDevelopment()
+	assert.NilError(t, err)
+
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{configMap}))
+	assert.NilError(t, err)
+
+	i := NewInstaller(&manifest, fakeClient, k8sfake.NewSimpleClientset(), logger.Sugar())
+	i.SetDriftDetection(v1alpha1.DriftPolicyReportOnly, true)
+
+	err = i.EnsureNamespaceScopedResources()
+	assert.NilError(t, err)
+	assert.Equal(t, len(i.DriftedResources()), 1)
+
+	existing, err := fakeClient.Get(&configMap)
+	assert.NilError(t, err)
+	value, _, err := unstructured.NestedString(existing.Object, "data", "default-timeout-minutes")
+	assert.NilError(t, err)
+	assert.Equal(t, value, "120")
+}
+
+func TestRecordDriftEvents(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+
+	drifted := v1alpha1.DriftedResource{Kind: "ConfigMap", Namespace: "test", Name: "config-defaults", Fields: []string{"data.default-timeout-minutes"}}
+	installerSet := &v1alpha1.TektonInstallerSet{}
+	installerSet.Status.DriftedResources = []v1alpha1.DriftedResource{drifted}
+
+	// a new drift is reported
+	recordDriftEvents(ctx, installerSet, &v1alpha1.TektonInstallerSetStatus{})
+	assert.Equal(t, len(recorder.Events), 1)
+	<-recorder.Events
+
+	// the same drift is reported only once
+	previous := installerSet.Status.DeepCopy()
+	recordDriftEvents(ctx, installerSet, previous)
+	assert.Equal(t, len(recorder.Events), 0)
+
+	// a change of the drifted fields is reported again
+	installerSet.Status.DriftedResources[0].Fields = []string{"data.default-timeout-minutes", "data.default-service-account"}
+	recordDriftEvents(ctx, installerSet, previous)
+	assert.Equal(t, len(recorder.Events), 1)
+}
+
+func TestReportResources_KeepsResourcesNotReached(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+
+	reverted := v1alpha1.DriftedResource{Kind: "ConfigMap", Namespace: "test", Name: "config-defaults", Fields: []string{"data.default-timeout-minutes"}}
+	notReached := v1alpha1.DriftedResource{Kind: "Deployment", Namespace: "test", Name: "controller", Fields: []string{"spec.replicas"}}
+	installerSet := &v1alpha1.TektonInstallerSet{}
+	installerSet.Status.DriftedResources = []v1alpha1.DriftedResource{reverted, notReached}
+
+	// the reconcile ended before the deployments, only the configmap was reached
+	configMap := namespacedResource("v1", "ConfigMap", "test", "config-defaults")
+	i := &installer{}
+	i.visit(&configMap)
+
+	reportResources(ctx, installerSet, i)
+	assert.DeepEqual(t, installerSet.Status.DriftedResources, []v1alpha1.DriftedResource{notReached})
+	assert.Equal(t, len(recorder.Events), 0)
+}
+
+func TestEnsureResource_DriftReportOnly(t *testing.T) {
+	ctx := context.TODO()
+	logger, err := zap.NewDevelopment()
+	assert.NilError(t, err)
+
+	i := installer{
+		mfClient:      fake.New(),
+		kubeClientSet: k8sfake.NewSimpleClientset(),
+		logger:        logger.Sugar(),
+	}
+
+	// deployment scaled manually on the cluster
+	_scaledObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(getDeployment("foo-1", "bar", 3))
+	assert.NilError(t, err)
+	scaled := &unstructured.Unstructured{Object: _scaledObject}
+	err = i.ensureResource(ctx, scaled.DeepCopy())
+	assert.NilError(t, err)
+
+	_depObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(getDeployment("foo-1", "bar", 1))
+	assert.NilError(t, err)
+	expected := &unstructured.Unstructured{Object: _depObject}
+
+	// manifests are not applied yet, the change is not a drift
+	i.SetDriftDetection(v1alpha1.DriftPolicyReportOnly, false)
+	err = i.ensureResource(ctx, expected.DeepCopy())
+	assert.NilError(t, err)
+	assert.Equal(t, len(i.DriftedResources()), 0)
+
+	// scale again after the manifests are applied, drift is reported but not reverted
+	err = i.ensureResource(ctx, scaled.DeepCopy())
+	assert.NilError(t, err)
+	i.SetDriftDetection(v1alpha1.DriftPolicyReportOnly, true)
+	err = i.ensureResource(ctx, expected.DeepCopy())
+	assert.NilError(t, err)
+
+	existing, err := i.mfClient.Get(expected)
+	assert.NilError(t, err)
+	replicas, _, err := unstructured.NestedInt64(existing.Object, "spec", "replicas")
+	assert.NilError(t, err)
+	assert.Equal(t, replicas, int64(3))
+
+	drifted := i.DriftedResources()
+	assert.Equal(t, len(drifted), 1)
+	assert.DeepEqual(t, drifted[0].Fields, []string{"spec.replicas"})
+
+	// enforce reverts the drift
+	i.SetDriftDetection(v1alpha1.DriftPolicyEnforce, true)
+	err = i.ensureResource(ctx, expected.DeepCopy())
+	assert.NilError(t, err)
+	existing, err = i.mfClient.Get(expected)
+	assert.NilError(t, err)
+	replicas, _, err = unstructured.NestedInt64(existing.Object, "spec", "replicas")
+	assert.NilError(t, err)
+	assert.Equal(t, replicas, int64(1))
+}
+
+func TestEnsureResources_DriftKeepsPreemptedFailurePolicy(t *testing.T) {
+	webhookConfig := namespacedResource("admissionregistration.k8s.io/v1", "MutatingWebhookConfiguration", "", "webhook.pipeline.tekton.dev")
+	webhookConfig.Object["webhooks"] = []interface{}{
+		map[string]interface{}{
+			"name":          "webhook.pipeline.tekton.dev",
+			"failurePolicy": "Fail",
+			"clientConfig": map[string]interface{}{
+				"service": map[string]interface{}{"name": "tekton-pipelines-webhook", "namespace": "tekton-pipelines"},
+			},
+		},
+	}
+	service := namespacedResource("v1", "Service", "tekton-pipelines", "tekton-pipelines-webhook")
+
+	fakeClient := fake.New()
+	logger, err := zap.NewDevelopment()
+	assert.NilError(t, err)
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{webhookConfig, service}), mf.UseClient(fakeClient))
+	assert.NilError(t, err)
+
+	i := NewInstaller(&manifest, fakeClient, k8sfake.NewSimpleClientset(), logger.Sugar())
+	i.SetDriftDetection(v1alpha1.DriftPolicyEnforce, false)
+	assert.NilError(t, i.EnsureClusterScopedResources())
+
+	// the webhook endpoints are gone, the failurePolicy is relaxed
+	pipeline := &v1alpha1.TektonPipeline{
+		Spec: v1alpha1.TektonPipelineSpec{CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"}},
+	}
+	assert.NilError(t, common.PreemptDeadlock(context.TODO(), &manifest, k8sfake.NewSimpleClientset(), pipeline))
+
+	// the relaxed failurePolicy is not a drift to revert
+	i = NewInstaller(&manifest, fakeClient, k8sfake.NewSimpleClientset(), logger.Sugar())
+	i.SetDriftDetection(v1alpha1.DriftPolicyEnforce, true)
+	assert.NilError(t, i.EnsureClusterScopedResources())
+	assert.Equal(t, len(i.DriftedResources()), 0)
+
+	existing, err := fakeClient.Get(&webhookConfig)
+	assert.NilError(t, err)
+	webhooks, _, err := unstructured.NestedSlice(existing.Object, "webhooks")
+	assert.NilError(t, err)
+	assert.Equal(t, webhooks[0].(map[string]interface{})["failurePolicy"], "Ignore")
+	_, found := existing.GetAnnotations()[common.PreemptedFailurePoliciesKey]
+	assert.Equal(t, found, true)
+}
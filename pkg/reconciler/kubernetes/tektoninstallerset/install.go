@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 
+	mfDynamic "github.com/manifestival/client-go-client/pkg/dynamic"
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/reconciler/common"
@@ -34,6 +35,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"knative.dev/pkg/logging"
@@ -56,6 +58,26 @@ type installer struct {
 	deployment      []unstructured.Unstructured
 	statefulset     []unstructured.Unstructured
 	job             []unstructured.Unstructured
+	// resources matching these selectors are not updated once they exist
+	unmanagedSelectors []v1alpha1.ResourceSelector
+	// resources skipped during this reconcile as they are not managed
+	unmanaged []v1alpha1.UnmanagedResource
+	// decides if the drifted resources are reverted or only reported
+	driftPolicy string
+	// true if the manifests were already applied, required to detect drift
+	specApplied bool
+	// resources found changed on the cluster during this reconcile
+	drifted []v1alpha1.DriftedResource
+	// applies the resources with server-side apply if set
+	resourceGetter mfDynamic.ResourceGetter
+	// decides if the fields owned by other field managers are taken over or reported
+	conflictPolicy string
+	// resources not applied during this reconcile as their fields are owned by other managers
+	conflicted []v1alpha1.ConflictedResource
+	// serves the reads of the cached kinds if set
+	cache *resourceCache
+	// resources reached during this reconcile, the reports of the others are not known
+	visited sets.String
 }
 
 func NewInstaller(manifest *mf.Manifest, mfClient mf.Client, kubeClientSet kubernetes.Interface, logger *zap.SugaredLogger) *installer {
@@ -99,6 +121,64 @@ func NewInstaller(manifest *mf.Manifest, mfClient mf.Client, kubeClientSet kuber
 	return installer
 }
 
+// SetUnmanagedSelectors sets the selectors of resources which should be left
+// alone by the installer once they exist on the cluster
+func (i *installer) SetUnmanagedSelectors(selectors []v1alpha1.ResourceSelector) {
+	i.unmanagedSelectors = selectors
+}
+
+// UnmanagedResources returns the resources which were not updated as they
+// are opted out of reconciliation
+func (i *installer) UnmanagedResources() []v1alpha1.UnmanagedResource {
+	return i.unmanaged
+}
+
+// Visited returns true if the resource was reached during this reconcile,
+// the reports of the resources which were not reached are unknown
+func (i *installer) Visited(kind, namespace, name string) bool {
+	return i.visited.Has(resourceKey(kind, namespace, name))
+}
+
+func (i *installer) visit(u *unstructured.Unstructured) {
+	if i.visited == nil {
+		i.visited = sets.NewString()
+	}
+	i.visited.Insert(resourceKey(u.GetKind(), u.GetNamespace(), u.GetName()))
+}
+
+func resourceKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// isUnmanaged checks if the existing resource is opted out of reconciliation either
+// by the managed annotation on the live resource or by a selector from TektonConfig,
+// and records it if so
+func (i *installer) isUnmanaged(existing *unstructured.Unstructured) bool {
+	reason := ""
+	if strings.EqualFold(existing.GetAnnotations()[v1alpha1.ManagedKey], "false") {
+		reason = fmt.Sprintf("resource has annotation %s: \"false\"", v1alpha1.ManagedKey)
+	} else {
+		for _, selector := range i.unmanagedSelectors {
+			if selector.Matches(existing.GetAPIVersion(), existing.GetKind(), existing.GetNamespace(), existing.GetName()) {
+				reason = "resource is selected in TektonConfig spec.unmanagedResources"
+				break
+			}
+		}
+	}
+	if reason == "" {
+		return false
+	}
+
+	i.unmanaged = append(i.unmanaged, v1alpha1.UnmanagedResource{
+		APIVersion: existing.GetAPIVersion(),
+		Kind:       existing.GetKind(),
+		Namespace:  existing.GetNamespace(),
+		Name:       existing.GetName(),
+		Reason:     reason,
+	})
+	return true
+}
+
 // https://github.com/manifestival/manifestival/blob/af1baacf01ec54390c3cbd46ee561d52b2b4ab14/transform.go#L107
 func isClusterScoped(kind string) bool {
 	switch strings.ToLower(kind) {
@@ -128,6 +208,7 @@ func isClusterScoped(kind string) bool {
 
 func (i *installer) ensureResources(resources []unstructured.Unstructured) error {
 	for _, r := range resources {
+		i.visit(&r)
 		ressourceLogger := i.logger.With(
 			"kind", r.GetKind(),
 			"namespace", r.GetNamespace(),
@@ -140,7 +221,7 @@ func (i *installer) ensureResources(resources []unstructured.Unstructured) error
 		}
 		ressourceLogger.Debug("fetching resource")
 
-		res, err := i.mfClient.Get(&r)
+		res, err := i.get(&r)
 		if err != nil {
 			if apierrs.IsNotFound(err) {
 				ressourceLogger.Debug("creating new resource")
@@ -151,7 +232,15 @@ func (i *installer) ensureResources(resources []unstructured.Unstructured) error
 				}
 				anno[v1alpha1.LastAppliedHashKey] = expectedHash
 				r.SetAnnotations(anno)
-				err = i.mfClient.Create(&r)
+				if i.serverSideApplyEnabled() {
+					err = i.serverSideApply(context.TODO(), &r)
+				} else {
+					err = i.mfClient.Create(&r)
+				}
+				if apierrs.IsAlreadyExists(err) {
+					ressourceLogger.Debug("resource created since the cache was synced, will reconcile again")
+					return v1alpha1.RECONCILE_AGAIN_ERR
+				}
 				if err != nil {
 					ressourceLogger.Error("failed to create resource", "error", err)
 					return err
@@ -168,21 +257,41 @@ func (i *installer) ensureResources(resources []unstructured.Unstructured) error
 			return v1alpha1.RECONCILE_AGAIN_ERR
 		}
 
+		if i.isUnmanaged(res) {
+			ressourceLogger.Debug("resource is not managed by the operator, skipping update")
+			continue
+		}
+
 		ressourceLogger.Debug("resource exists, checking for updates")
 
+		// the failurePolicy relaxed by the deadlock preemption stays until the webhook endpoints are ready
+		if err := common.KeepPreemptedFailurePolicies(&r, res); err != nil {
+			ressourceLogger.Error("failed to keep the preempted failurePolicy", "error", err)
+			return err
+		}
+
 		// if resource exist then check if expected hash is different from the one
 		// on the resource
 		hashOnResource := res.GetAnnotations()[v1alpha1.LastAppliedHashKey]
 
 		if expectedHash == hashOnResource {
-			ressourceLogger.Debug("resource is up-to-date, no changes needed")
-			continue
+			// manifest is not changed since the last apply, any difference is a manual change
+			fields := objectDriftedFields(&r, res)
+			if len(fields) == 0 {
+				ressourceLogger.Debug("resource is up-to-date, no changes needed")
+				continue
+			}
+			if i.recordDrift(res, fields) {
+				ressourceLogger.Debug("drift detected, reporting only as per the drift policy")
+				continue
+			}
+			ressourceLogger.Debug("drift detected, reverting the resource")
+		} else {
+			ressourceLogger.Debug("resource needs update",
+				"currentHash", hashOnResource,
+				"expectedHash", expectedHash)
 		}
 
-		ressourceLogger.Debug("resource needs update",
-			"currentHash", hashOnResource,
-			"expectedHash", expectedHash)
-
 		anno := r.GetAnnotations()
 		if anno == nil {
 			anno = map[string]string{}
@@ -190,6 +299,15 @@ func (i *installer) ensureResources(resources []unstructured.Unstructured) error
 		anno[v1alpha1.LastAppliedHashKey] = expectedHash
 		r.SetAnnotations(anno)
 
+		if i.serverSideApplyEnabled() {
+			if err := i.serverSideApply(context.TODO(), &r); err != nil {
+				ressourceLogger.Error("failed to apply resource", "error", err)
+				return err
+			}
+			ressourceLogger.Debug("resource applied successfully")
+			continue
+		}
+
 		installManifests, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{r}), mf.UseClient(i.mfClient))
 		if err != nil {
 			ressourceLogger.Error("failed to create manifest", "error", err)
@@ -266,6 +384,7 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 		"kind", expected.GetKind(),
 	)
 	loggerWithContext.Debug("verifying a resource")
+	i.visit(expected)
 
 	// update specific things to deployments and statefulSets
 	if expected.GetKind() == "Deployment" || expected.GetKind() == "StatefulSet" {
@@ -281,7 +400,7 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 		// and take replicas from HPA status(DesiredReplicas)
 
 		// lists the available HPAs
-		hpaList, err := i.kubeClientSet.AutoscalingV2().HorizontalPodAutoscalers(expected.GetNamespace()).List(ctx, metav1.ListOptions{})
+		hpas, err := i.listHPAs(ctx, expected.GetNamespace())
 		if err != nil {
 			loggerWithContext.Errorw("failed to list HPAs", "error", err)
 			return err
@@ -289,7 +408,7 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 
 		// check the expected resource configured with HPA
 		var hpa *autoscalingv2.HorizontalPodAutoscaler
-		for _, _hpa := range hpaList.Items {
+		for _, _hpa := range hpas {
 			target := _hpa.Spec.ScaleTargetRef
 			if target.Kind == expected.GetKind() && target.Name == expected.GetName() {
 				hpa = _hpa.DeepCopy()
@@ -297,6 +416,13 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 			}
 		}
 
+		// with server-side apply the replicas are left to the HPA, which owns them
+		if hpa != nil && i.serverSideApplyEnabled() {
+			loggerWithContext.Debugw("HPA found for resource, replicas are not applied", "hpaName", hpa.GetName())
+			unstructured.RemoveNestedField(expected.Object, "spec", "replicas")
+			hpa = nil
+		}
+
 		// if a hpa found to this resource, update replicas value from the hpa
 		if hpa != nil {
 			hpaLogger := loggerWithContext.With(
@@ -406,12 +532,20 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 	}
 
 	// check if the resource already exists
-	existing, err := i.mfClient.Get(expected)
+	existing, err := i.get(expected)
 	if err != nil {
 		// If the resource doesn't exist, then create new
 		if apierrs.IsNotFound(err) {
 			loggerWithContext.Debug("resource not found, creating")
-			err = i.mfClient.Create(expected)
+			if i.serverSideApplyEnabled() {
+				err = i.serverSideApply(ctx, expected)
+			} else {
+				err = i.mfClient.Create(expected)
+			}
+			if apierrs.IsAlreadyExists(err) {
+				loggerWithContext.Debug("resource created since the cache was synced, will reconcile again")
+				return v1alpha1.RECONCILE_AGAIN_ERR
+			}
 			if err != nil {
 				loggerWithContext.Errorw("failed to create resource", "error", err)
 				return err
@@ -429,6 +563,11 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 		return v1alpha1.RECONCILE_AGAIN_ERR
 	}
 
+	if i.isUnmanaged(existing) {
+		loggerWithContext.Debug("resource is not managed by the operator, skipping update")
+		return nil
+	}
+
 	// get list of reconcile fields
 	reconcileFields := i.resourceReconcileFields(expected)
 
@@ -455,11 +594,29 @@ func (i *installer) ensureResource(ctx context.Context, expected *unstructured.U
 
 	// if change detected in hash value, update the resource with changes
 	if existingHashValue != expectedHashValue {
+		// manifests are not changed since the last apply, the change is done on the cluster
+		if i.specApplied {
+			if fields := objectDriftedFields(expected, existingCloned, reconcileFields...); len(fields) > 0 && i.recordDrift(existing, fields) {
+				loggerWithContext.Debug("drift detected, reporting only as per the drift policy")
+				return nil
+			}
+		}
+
 		loggerWithContext.Debugw("change detected, updating resource",
 			"existingHash", existingHashValue,
 			"expectedHash", expectedHashValue,
 		)
 
+		// server-side apply keeps the fields of the other field managers, no need to copy them
+		if i.serverSideApplyEnabled() {
+			if err := i.serverSideApply(ctx, expected); err != nil {
+				loggerWithContext.Errorw("failed to apply resource", "error", err)
+				return v1alpha1.RECONCILE_AGAIN_ERR
+			}
+			loggerWithContext.Debug("resource applied successfully")
+			return nil
+		}
+
 		err = i.copyResourceFields(expected, existing, reconcileFields...)
 		if err != nil {
 			loggerWithContext.Errorw("failed to copy resource fields", "error", err)
@@ -641,7 +798,7 @@ func (i *installer) IsJobCompleted(ctx context.Context, labels map[string]string
 }
 
 func (i *installer) isStatefulSetAvailable(sfs *unstructured.Unstructured) error {
-	resource, err := i.mfClient.Get(sfs)
+	resource, err := i.get(sfs)
 	if err != nil {
 		return err
 	}
@@ -660,7 +817,7 @@ func (i *installer) isStatefulSetAvailable(sfs *unstructured.Unstructured) error
 }
 
 func (i *installer) isDeploymentReady(d *unstructured.Unstructured) error {
-	resource, err := i.mfClient.Get(d)
+	resource, err := i.get(d)
 	if err != nil {
 		return err
 	}
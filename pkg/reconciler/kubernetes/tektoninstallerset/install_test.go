@@ -142,6 +142,80 @@ func TestEnsureResources_WaitingDeletion(t *testing.T) {
 	assert.Error(t, err, v1alpha1.RECONCILE_AGAIN_ERR.Error())
 }
 
+func TestEnsureResources_UnmanagedResource(t *testing.T) {
+	k8sClient := k8sfake.NewSimpleClientset()
+
+	// service account opted out of reconciliation on cluster
+	sa := serviceAccount.DeepCopy()
+	sa.SetAnnotations(map[string]string{
+		v1alpha1.LastAppliedHashKey: "abcd",
+		v1alpha1.ManagedKey:         "false",
+	})
+
+	fakeClient := fake.New(sa)
+	observer, _ := zapobserver.New(zap.InfoLevel)
+	logger := zap.New(observer).Sugar()
+
+	manifest, err := mf.ManifestFrom(mf.Slice([]unstructured.Unstructured{serviceAccount}))
+	if err != nil {
+		t.Fatalf("Failed to generate manifest: %v", err)
+	}
+
+	i := NewInstaller(&manifest, fakeClient, k8sClient, logger)
+
+	err = i.EnsureNamespaceScopedResources()
+	assert.NilError(t, err)
+
+	// resource should not be updated
+	res, err := fakeClient.Get(&serviceAccount)
+	assert.NilError(t, err)
+	assert.Equal(t, res.GetAnnotations()[v1alpha1.LastAppliedHashKey], "abcd")
+
+	unmanaged := i.UnmanagedResources()
+	assert.Equal(t, len(unmanaged), 1)
+	assert.Equal(t, unmanaged[0].Kind, "ServiceAccount")
+	assert.Equal(t, unmanaged[0].Name, serviceAccount.GetName())
+}
+
+func TestEnsureResource_UnmanagedSelector(t *testing.T) {
+	ctx := context.TODO()
+	k8sClient := k8sfake.NewSimpleClientset()
+	logger, err := zap.NewDevelopment()
+	assert.NilError(t, err)
+
+	mfClient := fake.New()
+	i := installer{
+		mfClient:      mfClient,
+		kubeClientSet: k8sClient,
+		logger:        logger.Sugar(),
+	}
+
+	// hot-patched deployment on cluster
+	_patchedObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(getDeployment("foo-1", "bar", 3))
+	assert.NilError(t, err)
+	err = i.ensureResource(ctx, &unstructured.Unstructured{Object: _patchedObject})
+	assert.NilError(t, err)
+
+	i.SetUnmanagedSelectors([]v1alpha1.ResourceSelector{
+		{Kind: "Deployment", Namespace: "bar", Name: "foo-1"},
+	})
+
+	_depObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(getDeployment("foo-1", "bar", 1))
+	assert.NilError(t, err)
+	expected := &unstructured.Unstructured{Object: _depObject}
+
+	err = i.ensureResource(ctx, expected.DeepCopy())
+	assert.NilError(t, err)
+
+	// replicas should not be reverted
+	existing, err := i.mfClient.Get(expected)
+	assert.NilError(t, err)
+	replicas, _, err := unstructured.NestedInt64(existing.Object, "spec", "replicas")
+	assert.NilError(t, err)
+	assert.Equal(t, replicas, int64(3))
+	assert.Equal(t, len(i.UnmanagedResources()), 1)
+}
+
 var (
 	notReadyStatefulset = &appsv1.StatefulSet{
 		TypeMeta: metav1.TypeMeta{
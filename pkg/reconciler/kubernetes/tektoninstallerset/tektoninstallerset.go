@@ -18,15 +18,24 @@ package tektoninstallerset
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	mfDynamic "github.com/manifestival/client-go-client/pkg/dynamic"
 	mf "github.com/manifestival/manifestival"
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	clientset "github.com/tektoncd/operator/pkg/client/clientset/versioned"
 	tektonInstallerreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektoninstallerset"
+	listers "github.com/tektoncd/operator/pkg/client/listers/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
@@ -35,7 +44,13 @@ import (
 type Reconciler struct {
 	operatorClientSet clientset.Interface
 	mfClient          mf.Client
-	kubeClientSet     kubernetes.Interface
+	// resourceGetter is used to apply the resources with server-side apply
+	resourceGetter mfDynamic.ResourceGetter
+	// resourceCache serves the reads of the kinds watched by the controller
+	resourceCache *resourceCache
+	kubeClientSet kubernetes.Interface
+	// tektonConfigLister is used to read the installer settings from TektonConfig
+	tektonConfigLister listers.TektonConfigLister
 }
 
 // Reconciler implements controller.Reconciler
@@ -46,7 +61,12 @@ var _ tektonInstallerreconciler.Finalizer = (*Reconciler)(nil)
 func (r *Reconciler) FinalizeKind(ctx context.Context, installerSet *v1alpha1.TektonInstallerSet) pkgreconciler.Event {
 	logger := logging.FromContext(ctx)
 
-	deleteManifests, err := mf.ManifestFrom(installerSet.Spec.Manifests, mf.UseClient(r.mfClient))
+	resources, err := installerSet.Spec.GetManifests()
+	if err != nil {
+		logger.Error("Error reading the manifests: ", err)
+		return err
+	}
+	deleteManifests, err := mf.ManifestFrom(resources, mf.UseClient(r.mfClient))
 	if err != nil {
 		logger.Error("Error creating initial manifest: ", err)
 		installerSet.Status.MarkNotReady(fmt.Sprintf("Internal Error: failed to create manifest: %s", err.Error()))
@@ -77,7 +97,18 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 		"resourceVersion", installerSet.ResourceVersion,
 		"status", installerSet.Status.GetCondition(apis.ConditionReady))
 
-	installManifests, err := mf.ManifestFrom(installerSet.Spec.Manifests, mf.UseClient(r.mfClient))
+	// warn before the spec reaches the object size limit of etcd
+	if spec, err := json.Marshal(installerSet.Spec); err == nil {
+		installerSet.Status.MarkManifestSize(len(spec))
+	}
+
+	resources, err := installerSet.Spec.GetManifests()
+	if err != nil {
+		logger.Errorw("Failed to read the manifests", "error", err)
+		installerSet.Status.MarkNotReady(fmt.Sprintf("Internal Error: failed to read manifests: %s", err.Error()))
+		return err
+	}
+	installManifests, err := mf.ManifestFrom(resources, mf.UseClient(r.mfClient))
 	if err != nil {
 		msg := fmt.Sprintf("Internal Error: failed to create manifest: %s", err.Error())
 		logger.Errorw("Failed to create initial manifest", "error", err)
@@ -102,6 +133,39 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	}
 
 	installer := NewInstaller(&installManifests, r.mfClient, r.kubeClientSet, logger)
+	if r.resourceCache != nil {
+		installer.SetResourceCache(r.resourceCache)
+	}
+
+	tcSpec, err := r.tektonConfigSpec()
+	if err != nil {
+		logger.Errorw("Failed to get installer settings from TektonConfig", "error", err)
+		return err
+	}
+
+	specHash, err := hash.Compute(installerSet.Spec)
+	if err != nil {
+		logger.Errorw("Failed to compute hash of the spec", "error", err)
+		return err
+	}
+
+	driftPolicy := v1alpha1.DriftPolicyEnforce
+	if tcSpec != nil {
+		installer.SetUnmanagedSelectors(tcSpec.UnmanagedResources)
+		if tcSpec.DriftPolicy != "" {
+			driftPolicy = tcSpec.DriftPolicy
+		}
+		if tcSpec.ServerSideApply != nil && r.resourceGetter != nil {
+			conflictPolicy := tcSpec.ServerSideApply.ConflictPolicy
+			if conflictPolicy == "" {
+				conflictPolicy = v1alpha1.ConflictPolicyForce
+			}
+			installer.SetServerSideApply(r.resourceGetter, conflictPolicy)
+		}
+	}
+	installer.SetDriftDetection(driftPolicy, installerSet.Status.AppliedSpecHash == specHash)
+	// report the resources skipped or found drifted by the installer, whichever stage the reconcile ends in
+	defer reportResources(ctx, installerSet, installer)
 
 	// Install CRDs
 	logger.Debug("Installing CRDs")
@@ -179,6 +243,9 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 
 	// Update Status for StatefulSet Resources
 	installerSet.Status.MarkStatefulSetReady()
+
+	// all the resources are applied, any further change on them is a drift until the spec changes
+	installerSet.Status.AppliedSpecHash = specHash
 	logger.Debug("StatefulSet resources installed successfully")
 
 	// Check if webhook is ready
@@ -238,6 +305,89 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, installerSet *v1alpha1.T
 	return nil
 }
 
+// tektonConfigSpec returns the spec of TektonConfig which holds the installer settings
+// like the resources opted out of reconciliation and the drift policy
+func (r *Reconciler) tektonConfigSpec() (*v1alpha1.TektonConfigSpec, error) {
+	if r.tektonConfigLister == nil {
+		return nil, nil
+	}
+	tc, err := r.tektonConfigLister.Get(v1alpha1.ConfigResourceName)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &tc.Spec, nil
+}
+
+// recordDriftEvents emits an event on the installerSet for each drifted or conflicted resource,
+// the resources already reported with the same fields in the previous status are not reported again
+// reportResources sets the resources skipped, drifted or conflicted during this reconcile
+// in the status and records events for the new ones
+func reportResources(ctx context.Context, installerSet *v1alpha1.TektonInstallerSet, installer *installer) {
+	previous := installerSet.Status.DeepCopy()
+	installerSet.Status.UnmanagedResources = installer.UnmanagedResources()
+	installerSet.Status.DriftedResources = installer.DriftedResources()
+	installerSet.Status.ConflictedResources = installer.ConflictedResources()
+	// a reconcile ending early does not reach all the resources, keep their previous reports
+	for _, res := range previous.UnmanagedResources {
+		if !installer.Visited(res.Kind, res.Namespace, res.Name) {
+			installerSet.Status.UnmanagedResources = append(installerSet.Status.UnmanagedResources, res)
+		}
+	}
+	for _, res := range previous.DriftedResources {
+		if !installer.Visited(res.Kind, res.Namespace, res.Name) {
+			installerSet.Status.DriftedResources = append(installerSet.Status.DriftedResources, res)
+		}
+	}
+	for _, res := range previous.ConflictedResources {
+		if !installer.Visited(res.Kind, res.Namespace, res.Name) {
+			installerSet.Status.ConflictedResources = append(installerSet.Status.ConflictedResources, res)
+		}
+	}
+	recordDriftEvents(ctx, installerSet, previous)
+}
+
+func recordDriftEvents(ctx context.Context, installerSet *v1alpha1.TektonInstallerSet, previous *v1alpha1.TektonInstallerSetStatus) {
+	recorder := controller.GetEventRecorder(ctx)
+	if recorder == nil {
+		return
+	}
+	reported := sets.NewString()
+	for _, drifted := range previous.DriftedResources {
+		reported.Insert(driftKey(drifted.Kind, drifted.Namespace, drifted.Name, drifted.Fields))
+	}
+	for _, conflicted := range previous.ConflictedResources {
+		reported.Insert(driftKey(conflicted.Kind, conflicted.Namespace, conflicted.Name, conflicted.Fields))
+	}
+
+	for _, drifted := range installerSet.Status.DriftedResources {
+		if reported.Has(driftKey(drifted.Kind, drifted.Namespace, drifted.Name, drifted.Fields)) {
+			continue
+		}
+		lastChangedBy := drifted.LastChangedBy
+		if lastChangedBy == "" {
+			lastChangedBy = "unknown"
+		}
+		recorder.Eventf(installerSet, corev1.EventTypeWarning, "ResourceDrifted",
+			"%s %s/%s changed outside of the operator, fields: %s, last changed by: %s",
+			drifted.Kind, drifted.Namespace, drifted.Name, strings.Join(drifted.Fields, ", "), lastChangedBy)
+	}
+	for _, conflicted := range installerSet.Status.ConflictedResources {
+		if reported.Has(driftKey(conflicted.Kind, conflicted.Namespace, conflicted.Name, conflicted.Fields)) {
+			continue
+		}
+		recorder.Eventf(installerSet, corev1.EventTypeWarning, "ResourceConflicted",
+			"%s %s/%s not applied, fields: %s, owned by: %s",
+			conflicted.Kind, conflicted.Namespace, conflicted.Name, strings.Join(conflicted.Fields, ", "), strings.Join(conflicted.Managers, ", "))
+	}
+}
+
+func driftKey(kind, namespace, name string, fields []string) string {
+	return fmt.Sprintf("%s/%s/%s:%s", kind, namespace, name, strings.Join(fields, ","))
+}
+
 func (r *Reconciler) handleError(err error, installerSet *v1alpha1.TektonInstallerSet) error {
 	if err == v1alpha1.RECONCILE_AGAIN_ERR {
 		return v1alpha1.REQUEUE_EVENT_AFTER
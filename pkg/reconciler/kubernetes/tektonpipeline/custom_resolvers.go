@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonpipeline
+
+import (
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/ptr"
+)
+
+const (
+	// resolverTypeLabel is the label of the resolver type of the resolution requests
+	resolverTypeLabel = "resolution.tekton.dev/type"
+	// customResolverContainer is the container of the Deployment of a custom resolver, not named
+	// after a container of Pipelines to keep its image out of the images of the components
+	customResolverContainer = "custom-resolver"
+	// customResolverClusterRolePrefix keeps the cluster wide RBAC of a resolver apart from the existing ClusterRoles
+	customResolverClusterRolePrefix = "tekton-custom-resolver-"
+)
+
+// customResolverRules are the permissions of the resolvers of Pipelines on the resolution requests
+var customResolverRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"resolution.tekton.dev"},
+		Resources: []string{"resolutionrequests", "resolutionrequests/status"},
+		Verbs:     []string{"get", "list", "watch", "update", "patch"},
+	},
+}
+
+// customResolverNamespaceRules are the permissions of a resolver in the target namespace, to read its
+// config and to elect a leader
+var customResolverNamespaceRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"configmaps"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"coordination.k8s.io"},
+		Resources: []string{"leases"},
+		Verbs:     []string{"get", "list", "create", "update", "delete", "patch", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"events"},
+		Verbs:     []string{"create", "update", "patch"},
+	},
+}
+
+// customResolversManifest returns the Deployment, the ConfigMap and the RBAC of the custom resolvers,
+// they are deployed along the resolvers of Pipelines by the pipelines installer sets
+func customResolversManifest(pipeline *v1alpha1.TektonPipeline) (*mf.Manifest, error) {
+	namespace := pipeline.Spec.GetTargetNamespace()
+	objects := []apimachineryRuntime.Object{}
+	for _, resolver := range pipeline.Spec.CustomResolvers {
+		objects = append(objects, customResolverObjects(resolver, namespace)...)
+	}
+
+	resources := []unstructured.Unstructured{}
+	for _, obj := range objects {
+		u, err := apimachineryRuntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, unstructured.Unstructured{Object: u})
+	}
+	manifest, err := mf.ManifestFrom(mf.Slice(resources))
+	if err != nil {
+		return nil, err
+	}
+	// the resolvers run with the restricted security context of the operands
+	if manifest, err = manifest.Transform(common.AddDeploymentRestrictedPSA()); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+func customResolverObjects(resolver v1alpha1.CustomResolver, namespace string) []apimachineryRuntime.Object {
+	labels := map[string]string{
+		"app.kubernetes.io/name":      resolver.Name,
+		"app.kubernetes.io/component": "resolvers",
+		"app.kubernetes.io/part-of":   "tekton-pipelines",
+		resolverTypeLabel:             resolver.ResolverType,
+	}
+	meta := func(kind, apiVersion, ns string) (metav1.TypeMeta, metav1.ObjectMeta) {
+		return metav1.TypeMeta{Kind: kind, APIVersion: apiVersion},
+			metav1.ObjectMeta{Name: resolver.Name, Namespace: ns, Labels: labels}
+	}
+	configMapName := resolver.Name + "-config"
+	image := common.ImageRegistryDomainOverride(map[string]string{resolver.Name: resolver.Image})[resolver.Name]
+
+	saType, saMeta := meta("ServiceAccount", "v1", namespace)
+	cmType, cmMeta := meta("ConfigMap", "v1", namespace)
+	cmMeta.Name = configMapName
+	crType, crMeta := meta("ClusterRole", rbacv1.SchemeGroupVersion.String(), "")
+	crMeta.Name = customResolverClusterRolePrefix + resolver.Name
+	crbType, crbMeta := meta("ClusterRoleBinding", rbacv1.SchemeGroupVersion.String(), "")
+	crbMeta.Name = crMeta.Name
+	roleType, roleMeta := meta("Role", rbacv1.SchemeGroupVersion.String(), namespace)
+	rbType, rbMeta := meta("RoleBinding", rbacv1.SchemeGroupVersion.String(), namespace)
+	deployType, deployMeta := meta("Deployment", appsv1.SchemeGroupVersion.String(), namespace)
+	subjects := []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: resolver.Name, Namespace: namespace}}
+
+	env := []corev1.EnvVar{
+		{Name: "SYSTEM_NAMESPACE", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
+		{Name: "CONFIG_LOGGING_NAME", Value: "config-logging"},
+		{Name: "CONFIG_OBSERVABILITY_NAME", Value: ConfigMetrics},
+		{Name: "CONFIG_FEATURE_FLAGS_NAME", Value: FeatureFlag},
+		{Name: "CONFIG_LEADERELECTION_NAME", Value: leaderElectionResolversConfig},
+		{Name: "METRICS_DOMAIN", Value: "tekton.dev/resolution"},
+		{Name: "RESOLVER_TYPE", Value: resolver.ResolverType},
+		{Name: "RESOLVER_CONFIG_NAME", Value: configMapName},
+	}
+	env = append(env, resolver.Env...)
+
+	return []apimachineryRuntime.Object{
+		&corev1.ServiceAccount{TypeMeta: saType, ObjectMeta: saMeta},
+		&corev1.ConfigMap{TypeMeta: cmType, ObjectMeta: cmMeta, Data: resolver.Config},
+		&rbacv1.ClusterRole{TypeMeta: crType, ObjectMeta: crMeta, Rules: append(append([]rbacv1.PolicyRule{}, customResolverRules...), resolver.Rules...)},
+		&rbacv1.ClusterRoleBinding{TypeMeta: crbType, ObjectMeta: crbMeta, Subjects: subjects,
+			RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: crMeta.Name}},
+		&rbacv1.Role{TypeMeta: roleType, ObjectMeta: roleMeta, Rules: customResolverNamespaceRules},
+		&rbacv1.RoleBinding{TypeMeta: rbType, ObjectMeta: rbMeta, Subjects: subjects,
+			RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: resolver.Name}},
+		&appsv1.Deployment{TypeMeta: deployType, ObjectMeta: deployMeta, Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.Int32(1),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": resolver.Name}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: resolver.Name,
+					Containers: []corev1.Container{{
+						Name:  customResolverContainer,
+						Image: image,
+						Env:   env,
+					}},
+				},
+			},
+		}},
+	}
+}
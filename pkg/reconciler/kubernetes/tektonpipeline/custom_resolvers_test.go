@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonpipeline
+
+import (
+	"context"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCustomResolversManifest(t *testing.T) {
+	t.Setenv(common.ImageRegistryOverride, "registry.example.com")
+	ctx := context.TODO()
+
+	tp := &v1alpha1.TektonPipeline{
+		Spec: v1alpha1.TektonPipelineSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Config: v1alpha1.Config{
+				NodeSelector: map[string]string{"node-role": "tekton"},
+			},
+			Pipeline: v1alpha1.Pipeline{
+				PipelineProperties: v1alpha1.PipelineProperties{
+					Resolvers: v1alpha1.Resolvers{
+						ResolversConfig: v1alpha1.ResolversConfig{
+							CustomResolvers: []v1alpha1.CustomResolver{{
+								Name:         "artifacts",
+								Image:        "quay.io/example/artifacts-resolver:v1",
+								ResolverType: "artifacts",
+								Config:       map[string]string{"url": "https://artifacts.example.com"},
+								Rules: []rbacv1.PolicyRule{{
+									APIGroups: []string{""},
+									Resources: []string{"secrets"},
+									Verbs:     []string{"get"},
+								}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	manifest, err := customResolversManifest(tp)
+	assert.NilError(t, err)
+
+	kinds := []string{}
+	for _, u := range manifest.Resources() {
+		kinds = append(kinds, u.GetKind()+"/"+u.GetName())
+	}
+	assert.DeepEqual(t, kinds, []string{
+		"ServiceAccount/artifacts",
+		"ConfigMap/artifacts-config",
+		"ClusterRole/tekton-custom-resolver-artifacts",
+		"ClusterRoleBinding/tekton-custom-resolver-artifacts",
+		"Role/artifacts",
+		"RoleBinding/artifacts",
+		"Deployment/artifacts",
+	})
+
+	cr := &rbacv1.ClusterRole{}
+	u := manifest.Filter(mf.ByKind("ClusterRole")).Resources()[0]
+	assert.NilError(t, apimachineryRuntime.DefaultUnstructuredConverter.FromUnstructured(u.Object, cr))
+	assert.Equal(t, len(cr.Rules), 2)
+	assert.DeepEqual(t, cr.Rules[1].Resources, []string{"secrets"})
+
+	cm := &corev1.ConfigMap{}
+	u = manifest.Filter(mf.ByKind("ConfigMap")).Resources()[0]
+	assert.NilError(t, apimachineryRuntime.DefaultUnstructuredConverter.FromUnstructured(u.Object, cm))
+	assert.DeepEqual(t, cm.Data, map[string]string{"url": "https://artifacts.example.com"})
+
+	// the custom resolvers go through the transformers of the pipelines installer sets
+	transformers := filterAndTransform(common.NoExtension(ctx))
+	_, err = transformers(ctx, manifest, tp)
+	assert.NilError(t, err)
+
+	d := &appsv1.Deployment{}
+	u = manifest.Filter(mf.ByKind("Deployment")).Resources()[0]
+	assert.NilError(t, apimachineryRuntime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d))
+	assert.Equal(t, d.Labels[resolverTypeLabel], "artifacts")
+	assert.DeepEqual(t, d.Spec.Template.Spec.NodeSelector, map[string]string{"node-role": "tekton"})
+	container := d.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, container.Image, "registry.example.com/example/artifacts-resolver:v1")
+	assert.Equal(t, *container.SecurityContext.AllowPrivilegeEscalation, false)
+	assert.Equal(t, *d.Spec.Template.Spec.SecurityContext.RunAsNonRoot, true)
+}
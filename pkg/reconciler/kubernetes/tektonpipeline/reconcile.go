@@ -135,9 +135,18 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tp *v1alpha1.TektonPipel
 	logger.Debug("Filtering out namespace from manifest")
 	manifest := r.manifest.Filter(mf.Not(mf.ByKind("Namespace")))
 
+	// the custom resolvers are part of the pipelines installer sets
+	customResolvers, err := customResolversManifest(tp)
+	if err != nil {
+		logger.Errorw("Failed to build the custom resolvers manifest", "error", err)
+		tp.Status.MarkInstallerSetNotReady(fmt.Sprintf("Custom resolvers: %s", err.Error()))
+		return nil
+	}
+	manifest = manifest.Append(*customResolvers)
+
 	// Ensure webhook deadlock prevention before applying the manifest
 	logger.Debug("Preempting webhook deadlock")
-	if err := common.PreemptDeadlock(ctx, &manifest, r.kubeClientSet, v1alpha1.PipelineResourceName); err != nil {
+	if err := common.PreemptDeadlock(ctx, &manifest, r.kubeClientSet, tp); err != nil {
 		logger.Errorw("Failed to preempt webhook deadlock", "error", err)
 		return err
 	}
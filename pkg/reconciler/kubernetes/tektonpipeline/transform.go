@@ -42,6 +42,7 @@ const (
 	clusterResolverConfig                        = "cluster-resolver-config"
 	hubResolverConfig                            = "hubresolver-config"
 	gitResolverConfig                            = "git-resolver-config"
+	httpResolverConfig                           = "http-resolver-config"
 	leaderElectionPipelineConfig                 = "config-leader-election-controller"
 	leaderElectionResolversConfig                = "config-leader-election-resolvers"
 	pipelinesControllerDeployment                = "tekton-pipelines-controller"
@@ -78,17 +79,19 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 			common.AddConfigMapValues(FeatureFlag, pipeline.Spec.PipelineProperties),
 			common.AddConfigMapValues(ConfigDefaults, pipeline.Spec.OptionalPipelineProperties),
 			common.AddConfigMapValues(ConfigMetrics, pipeline.Spec.PipelineMetricsProperties),
-			addTracingConfigValues(pipeline),
 			common.AddConfigMapValues(ResolverFeatureFlag, pipeline.Spec.Resolvers),
 			common.DeploymentImages(images),
 			common.StatefulSetImages(images),
 			common.DeploymentEnvVarKubernetesMinVersion(),
 			common.InjectLabelOnNamespace(proxyLabel),
 			common.AddConfiguration(pipeline.Spec.Config),
+			// after the global observability config, the tracing of the pipeline takes precedence
+			addTracingConfigValues(pipeline),
 			common.CopyConfigMap(bundleResolverConfig, pipeline.Spec.BundlesResolverConfig),
 			common.CopyConfigMap(hubResolverConfig, pipeline.Spec.HubResolverConfig),
 			common.CopyConfigMap(clusterResolverConfig, pipeline.Spec.ClusterResolverConfig),
 			common.CopyConfigMap(gitResolverConfig, pipeline.Spec.GitResolverConfig),
+			common.CopyConfigMap(httpResolverConfig, pipeline.Spec.HttpResolverConfig),
 			common.AddConfigMapValues(leaderElectionPipelineConfig, pipeline.Spec.Performance.PerformanceLeaderElectionConfig),
 			common.AddConfigMapValues(leaderElectionResolversConfig, pipeline.Spec.Performance.PerformanceLeaderElectionConfig),
 			common.UpdatePerformanceFlagsInDeploymentAndLeaderConfigMap(&pipeline.Spec.Performance, leaderElectionPipelineConfig, pipelinesControllerDeployment, pipelinesControllerContainer),
@@ -183,7 +183,10 @@ func (r *Reconciler) createConfigInstallerSet(ctx context.Context, tektonPruner
 	}
 
 	// generate installer set
-	tis := r.makeInstallerSet(tektonPruner, manifest, PrunerConfigInstallerSet)
+	tis, err := r.makeInstallerSet(tektonPruner, manifest, PrunerConfigInstallerSet)
+	if err != nil {
+		return nil, err
+	}
 
 	// compute the hash of  spec and store as an annotation
 	// in further reconciliation we compute hash of tektonPruner spec and check with
@@ -204,13 +207,13 @@ func (r *Reconciler) createConfigInstallerSet(ctx context.Context, tektonPruner
 	return createdIs, nil
 }
 
-func (r *Reconciler) makeInstallerSet(tc *v1alpha1.TektonPruner, manifest mf.Manifest, installerSetType string) *v1alpha1.TektonInstallerSet {
+func (r *Reconciler) makeInstallerSet(tc *v1alpha1.TektonPruner, manifest mf.Manifest, installerSetType string) (*v1alpha1.TektonInstallerSet, error) {
 	ownerRef := *metav1.NewControllerRef(tc, tc.GetGroupVersionKind())
 	labels := getLabels()
 
 	labels[v1alpha1.ReleaseVersionKey] = r.operatorVersion
 
-	return &v1alpha1.TektonInstallerSet{
+	tis := &v1alpha1.TektonInstallerSet{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: fmt.Sprintf("%s-", installerSetType),
 			Labels:       labels,
@@ -219,10 +222,11 @@ func (r *Reconciler) makeInstallerSet(tc *v1alpha1.TektonPruner, manifest mf.Man
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			Manifests: manifest.Resources(),
-		},
 	}
+	if err := tis.Spec.SetManifests(manifest.Resources()); err != nil {
+		return nil, err
+	}
+	return tis, nil
 }
 
 func getLabels() map[string]string {
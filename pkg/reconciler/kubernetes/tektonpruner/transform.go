@@ -35,6 +35,7 @@ func filterAndTransform(extension common.Extension) client.FilterAndTransform {
 			common.InjectOperandNameLabelOverwriteExisting(v1alpha1.TektonPrunerResourceName),
 			common.DeploymentImages(prunerImages),
 			common.AddDeploymentRestrictedPSA(),
+			common.AddConfiguration(prunerCR.Spec.Config),
 			common.AddConfigMapValues(PrunerConfigMapName, prunerCR.Spec.TektonPrunerConfig),
 		}
 		extra = append(extra, extension.Transformers(prunerCR)...)
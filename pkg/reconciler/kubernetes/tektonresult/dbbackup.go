@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// name, database and port of the operator managed database
+	internalDBName     = "tekton-results-postgres"
+	internalDBDatabase = "tekton-results"
+	internalDBPort     = "5432"
+
+	// the retention policy agent deletes the records from the database
+	retentionPolicyAgentDeployment = "tekton-results-retention-policy-agent"
+)
+
+// reconcileDBBackup schedules the backups of the operator managed database, and restores a backup
+// when TektonResult is annotated with operator.tekton.dev/restore-db-backup
+func (r *Reconciler) reconcileDBBackup(ctx context.Context, tr *v1alpha1.TektonResult) error {
+	db := internalDBBackupDatabase(tr)
+
+	backup := tr.Spec.DBBackup
+	if tr.Spec.IsExternalDB {
+		backup = nil
+	}
+	status, err := common.ReconcileDBBackup(ctx, r.kubeClientSet, db, backup, tr.Status.DBBackup)
+	tr.Status.DBBackup = status
+	if err != nil {
+		return err
+	}
+
+	if _, found := tr.GetAnnotations()[v1alpha1.RestoreDBBackupKey]; !found {
+		return nil
+	}
+	backupName, restoreErr := common.RequestedDBRestore(tr, tr.Status.DBBackup)
+	if restoreErr == nil {
+		var finished bool
+		finished, restoreErr = common.RestoreDBBackup(ctx, r.kubeClientSet, db, backup, backupName)
+		if !finished {
+			if restoreErr != nil {
+				return restoreErr
+			}
+			return v1alpha1.REQUEUE_EVENT_AFTER
+		}
+	}
+	return r.finishDBRestore(ctx, tr, backupName, restoreErr)
+}
+
+// finishDBRestore removes the restore annotation once the restore finished and reports the outcome
+func (r *Reconciler) finishDBRestore(ctx context.Context, tr *v1alpha1.TektonResult, backupName string, restoreErr error) error {
+	logger := logging.FromContext(ctx)
+
+	annotations := tr.GetAnnotations()
+	delete(annotations, v1alpha1.RestoreDBBackupKey)
+	tr.SetAnnotations(annotations)
+	if _, err := r.operatorClientSet.OperatorV1alpha1().TektonResults().Update(ctx, tr, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	recorder := controller.GetEventRecorder(ctx)
+	if restoreErr != nil {
+		logger.Errorw("database restore failed", "backup", backupName, "error", restoreErr)
+		if recorder != nil {
+			recorder.Eventf(tr, corev1.EventTypeWarning, "DBRestoreFailed", "%s", restoreErr.Error())
+		}
+		return nil
+	}
+
+	logger.Infow("database restored", "backup", backupName)
+	if tr.Status.DBBackup == nil {
+		tr.Status.DBBackup = &v1alpha1.DBBackupStatus{}
+	}
+	tr.Status.DBBackup.LastRestoredBackup = backupName
+	now := metav1.Now()
+	tr.Status.DBBackup.LastRestoreTime = &now
+	if recorder != nil {
+		recorder.Eventf(tr, corev1.EventTypeNormal, "DBRestored", "database restored from the backup %s", backupName)
+	}
+	return nil
+}
+
+func internalDBBackupDatabase(tr *v1alpha1.TektonResult) common.DBBackupDatabase {
+	secretKeyRef := func(key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: DefaultDbSecretName},
+				Key:                  key,
+			},
+		}
+	}
+	return common.DBBackupDatabase{
+		Name:      internalDBName,
+		Namespace: tr.Spec.GetTargetNamespace(),
+		OwnerRef:  getOwnerRef(tr),
+		Config:    tr.Spec.Config,
+		// the api, the watcher and the retention policy agent write to the database
+		Deployments: []string{resultAPIDeployment, resultWatcherDeployment, retentionPolicyAgentDeployment},
+		ConnectionEnv: []corev1.EnvVar{
+			{Name: "PGHOST", Value: servicePostgresDB + "." + tr.Spec.GetTargetNamespace() + ".svc.cluster.local"},
+			{Name: "PGPORT", Value: internalDBPort},
+			{Name: "PGDATABASE", Value: internalDBDatabase},
+			{Name: "PGUSER", ValueFrom: secretKeyRef("POSTGRES_USER")},
+			{Name: "PGPASSWORD", ValueFrom: secretKeyRef("POSTGRES_PASSWORD")},
+		},
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorfake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestReconcileDBBackup(t *testing.T) {
+	ctx := context.TODO()
+	tr := &v1alpha1.TektonResult{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        v1alpha1.ResultResourceName,
+			Annotations: map[string]string{v1alpha1.RestoreDBBackupKey: "tekton-results-postgres-backup-100"},
+		},
+		Spec: v1alpha1.TektonResultSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Result: v1alpha1.Result{
+				DBBackup: &v1alpha1.DBBackup{Schedule: "0 2 * * *", PVC: &v1alpha1.PVCBackupTarget{ClaimName: "backups"}},
+			},
+		},
+	}
+	kubeClient := k8sfake.NewSimpleClientset()
+	r := &Reconciler{
+		kubeClientSet:     kubeClient,
+		operatorClientSet: operatorfake.NewSimpleClientset(tr.DeepCopy()),
+	}
+
+	// the cron job is created and the restore waits for the restore job
+	err := r.reconcileDBBackup(ctx, tr)
+	assert.Equal(t, err, v1alpha1.REQUEUE_EVENT_AFTER)
+	cronJob, err := kubeClient.BatchV1().CronJobs("tekton-pipelines").Get(ctx, "tekton-results-postgres-backup", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env[3], corev1.EnvVar{
+		Name: "PGHOST", Value: "tekton-results-postgres-service.tekton-pipelines.svc.cluster.local",
+	})
+
+	job, err := kubeClient.BatchV1().Jobs("tekton-pipelines").Get(ctx, "tekton-results-postgres-restore", metav1.GetOptions{})
+	assert.NilError(t, err)
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	_, err = kubeClient.BatchV1().Jobs("tekton-pipelines").UpdateStatus(ctx, job, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+
+	// the finished restore is recorded and the annotation removed
+	err = r.reconcileDBBackup(ctx, tr)
+	assert.NilError(t, err)
+	assert.Equal(t, tr.Status.DBBackup.LastRestoredBackup, "tekton-results-postgres-backup-100")
+	assert.Assert(t, tr.Status.DBBackup.LastRestoreTime != nil)
+	updated, err := r.operatorClientSet.OperatorV1alpha1().TektonResults().Get(ctx, v1alpha1.ResultResourceName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	_, found := updated.GetAnnotations()[v1alpha1.RestoreDBBackupKey]
+	assert.Assert(t, !found)
+
+	// the cron job is removed for an external database
+	tr.Spec.IsExternalDB = true
+	err = r.reconcileDBBackup(ctx, tr)
+	assert.NilError(t, err)
+	_, err = kubeClient.BatchV1().CronJobs("tekton-pipelines").Get(ctx, "tekton-results-postgres-backup", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
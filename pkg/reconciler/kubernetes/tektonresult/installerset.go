@@ -43,7 +43,10 @@ func (r *Reconciler) createInstallerSet(ctx context.Context, tr *v1alpha1.Tekton
 	}
 
 	// create installer set
-	tis := r.makeInstallerSet(tr, manifest, specHash)
+	tis, err := r.makeInstallerSet(tr, manifest, specHash)
+	if err != nil {
+		return nil, err
+	}
 	createdIs, err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
 		Create(ctx, tis, metav1.CreateOptions{})
 	if err != nil {
@@ -52,7 +55,7 @@ func (r *Reconciler) createInstallerSet(ctx context.Context, tr *v1alpha1.Tekton
 	return createdIs, nil
 }
 
-func (r *Reconciler) makeInstallerSet(tr *v1alpha1.TektonResult, manifest mf.Manifest, trSpecHash string) *v1alpha1.TektonInstallerSet {
+func (r *Reconciler) makeInstallerSet(tr *v1alpha1.TektonResult, manifest mf.Manifest, trSpecHash string) (*v1alpha1.TektonInstallerSet, error) {
 	ownerRef := *metav1.NewControllerRef(tr, tr.GetGroupVersionKind())
 	// Determine the subtype based on statefulset mode.
 	mode := "deployment"
@@ -60,7 +63,7 @@ func (r *Reconciler) makeInstallerSet(tr *v1alpha1.TektonResult, manifest mf.Man
 		mode = "statefulset"
 	}
 
-	return &v1alpha1.TektonInstallerSet{
+	tis := &v1alpha1.TektonInstallerSet{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: fmt.Sprintf("%s-", v1alpha1.ResultResourceName),
 			Labels: map[string]string{
@@ -75,8 +78,9 @@ func (r *Reconciler) makeInstallerSet(tr *v1alpha1.TektonResult, manifest mf.Man
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			Manifests: manifest.Resources(),
-		},
 	}
+	if err := tis.Spec.SetManifests(manifest.Resources()); err != nil {
+		return nil, err
+	}
+	return tis, nil
 }
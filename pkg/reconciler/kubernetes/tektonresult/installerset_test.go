@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"strings"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMakeInstallerSet_CompressedManifests(t *testing.T) {
+	resources := []unstructured.Unstructured{{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "tekton-results-api-config", "namespace": "tekton-pipelines"},
+		"data":       map[string]interface{}{"config": strings.Repeat("a", v1alpha1.InstallerSetCompressThreshold)},
+	}}}
+	manifest, err := mf.ManifestFrom(mf.Slice(resources))
+	assert.NilError(t, err)
+
+	tr := &v1alpha1.TektonResult{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ResultResourceName},
+		Spec: v1alpha1.TektonResultSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+		},
+	}
+	r := &Reconciler{operatorVersion: "devel"}
+	tis, err := r.makeInstallerSet(tr, manifest, "hash")
+	assert.NilError(t, err)
+
+	assert.Assert(t, tis.Spec.Manifests == nil)
+	assert.Assert(t, len(tis.Spec.CompressedManifests) > 0)
+	manifests, err := tis.Spec.GetManifests()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []unstructured.Unstructured(manifests), resources)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonresult
+
+import (
+	"path"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRetentionPolicyConfig(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Recursive(path.Join("testdata", "retention-policy-config.yaml")))
+	assert.NilError(t, err)
+
+	maxRetention := uint(90)
+	retention := v1alpha1.ResultsRetention{RunAt: "0 3 * * *", MaxRetention: &maxRetention}
+	manifest, err = manifest.Transform(common.AddConfigMapValues(retentionPolicyConfigMap, retention))
+	assert.NilError(t, err)
+
+	cm := &corev1.ConfigMap{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, cm)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cm.Data, map[string]string{"runAt": "0 3 * * *", "maxRetention": "90"})
+}
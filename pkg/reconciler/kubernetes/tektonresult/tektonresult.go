@@ -234,6 +234,12 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 	tr.Status.MarkPreReconcilerComplete()
 	logger.Info("PreReconciliation completed successfully")
 
+	// Ensure webhook deadlock prevention before applying the manifest
+	if err := common.PreemptDeadlock(ctx, r.manifest, r.kubeClientSet, tr); err != nil {
+		logger.Errorw("Failed to preempt webhook deadlock", "error", err)
+		return err
+	}
+
 	// Check if an tektoninstallerset already exists, if not then create
 	labelSelector, err := common.LabelSelector(ls)
 	if err != nil {
@@ -348,7 +354,9 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 			installedTIS.SetAnnotations(current)
 
 			// Update the manifests
-			installedTIS.Spec.Manifests = manifest.Resources()
+			if err := installedTIS.Spec.SetManifests(manifest.Resources()); err != nil {
+				return err
+			}
 			updatedIS, err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().
 				Update(ctx, installedTIS, metav1.UpdateOptions{})
 			if err != nil {
@@ -393,6 +401,15 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 	tr.Status.MarkInstallerSetReady()
 	logger.Infow("Installer set is ready", "name", installedTIS.Name)
 
+	if err := r.reconcileDBBackup(ctx, tr); err != nil {
+		if err == v1alpha1.REQUEUE_EVENT_AFTER {
+			logger.Infow("Waiting for the database restore to finish")
+			return err
+		}
+		logger.Errorw("Failed to reconcile the database backup", "error", err)
+		return err
+	}
+
 	if err := r.extension.PostReconcile(ctx, tr); err != nil {
 		if err == v1alpha1.REQUEUE_EVENT_AFTER {
 			logger.Infow("PostReconciliation requested requeue")
@@ -413,6 +430,9 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tr *v1alpha1.TektonResul
 		"ready", tr.Status.GetCondition(apis.ConditionReady).IsTrue(),
 		"generation", tr.Status.ObservedGeneration)
 
+	if tr.Spec.DBBackup != nil && !tr.Spec.IsExternalDB {
+		return common.DBBackupStatusRequeue
+	}
 	return nil
 }
 
@@ -48,6 +48,9 @@ const (
 	googleAPPCredsEnvName             = "GOOGLE_APPLICATION_CREDENTIALS"
 	googleCredsVolName                = "google-creds"
 	googleCredsPath                   = "/creds/google"
+	s3CAVolName                       = "s3-ca"
+	s3CAPath                          = "/etc/tekton/results/s3-ca"
+	s3CABundleEnvName                 = "AWS_CA_BUNDLE"
 
 	loggingProxyPath              = "LOGGING_PLUGIN_PROXY_PATH"
 	loggingAPIURL                 = "LOGGING_PLUGIN_API_URL"
@@ -108,6 +111,7 @@ func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp
 		updateEnvWithSecretName(instance.Spec.ResultsAPIProperties),
 		updateEnvWithDBSecretName(instance.Spec.ResultsAPIProperties),
 		populateGoogleCreds(instance.Spec.ResultsAPIProperties),
+		populateS3Config(instance.Spec.Result),
 		common.AddDeploymentRestrictedPSA(),
 		common.AddConfiguration(instance.Spec.Config),
 		common.AddStatefulSetRestrictedPSA(),
@@ -126,6 +130,10 @@ func (r *Reconciler) transform(ctx context.Context, manifest *mf.Manifest, comp
 		)
 	}
 
+	if instance.Spec.Retention != nil {
+		extra = append(extra, common.AddConfigMapValues(retentionPolicyConfigMap, *instance.Spec.Retention))
+	}
+
 	extra = append(extra, r.extension.Transformers(instance)...)
 	err := common.Transform(ctx, manifest, instance, extra...)
 	if err != nil {
@@ -516,6 +524,124 @@ func populateGoogleCreds(props v1alpha1.ResultsAPIProperties) mf.Transformer {
 	}
 }
 
+// populateS3Config sets the typed S3 logs configuration as env of the "api" container,
+// the credentials and the CA bundle are taken from the referenced secrets
+func populateS3Config(result v1alpha1.Result) mf.Transformer {
+	return func(u *unstructured.Unstructured) error {
+		s3 := result.S3
+		if s3 == nil || result.LogsType != v1alpha1.LogsTypeS3 ||
+			u.GetKind() != "Deployment" || u.GetName() != deploymentAPI {
+			return nil
+		}
+
+		d := &appsv1.Deployment{}
+		err := k8sruntime.DefaultUnstructuredConverter.FromUnstructured(u.Object, d)
+		if err != nil {
+			return err
+		}
+
+		envs := []corev1.EnvVar{
+			{Name: "S3_BUCKET_NAME", Value: s3.Bucket},
+			{Name: "S3_REGION", Value: s3.Region},
+		}
+		if s3.Endpoint != "" {
+			envs = append(envs, corev1.EnvVar{Name: "S3_ENDPOINT", Value: s3.Endpoint})
+		}
+		if s3.ForcePathStyle != nil {
+			envs = append(envs, corev1.EnvVar{Name: "S3_HOSTNAME_IMMUTABLE", Value: strconv.FormatBool(*s3.ForcePathStyle)})
+		}
+		if s3.MultiPartSize != nil {
+			envs = append(envs, corev1.EnvVar{Name: "S3_MULTI_PART_SIZE", Value: strconv.FormatInt(*s3.MultiPartSize, 10)})
+		}
+		if s3.CredentialsSecretName != "" {
+			for _, key := range []string{"S3_ACCESS_KEY_ID", "S3_SECRET_ACCESS_KEY"} {
+				envs = append(envs, corev1.EnvVar{
+					Name: key,
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: s3.CredentialsSecretName},
+							Key:                  key,
+						},
+					},
+				})
+			}
+		}
+		if s3.CASecretName != "" {
+			envs = append(envs, corev1.EnvVar{Name: s3CABundleEnvName, Value: s3CAPath + "/" + s3.CASecretKey})
+		}
+
+		for i, container := range d.Spec.Template.Spec.Containers {
+			if container.Name != apiContainerName {
+				continue
+			}
+			for _, env := range envs {
+				add := true
+				for k := range d.Spec.Template.Spec.Containers[i].Env {
+					if d.Spec.Template.Spec.Containers[i].Env[k].Name == env.Name {
+						d.Spec.Template.Spec.Containers[i].Env[k] = env
+						add = false
+						break
+					}
+				}
+				if add {
+					d.Spec.Template.Spec.Containers[i].Env = append(d.Spec.Template.Spec.Containers[i].Env, env)
+				}
+			}
+
+			if s3.CASecretName == "" {
+				break
+			}
+			vol := corev1.Volume{
+				Name: s3CAVolName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: s3.CASecretName,
+						Items: []corev1.KeyToPath{{
+							Key:  s3.CASecretKey,
+							Path: s3.CASecretKey,
+						}},
+					},
+				},
+			}
+			add := true
+			for k := range d.Spec.Template.Spec.Volumes {
+				if d.Spec.Template.Spec.Volumes[k].Name == s3CAVolName {
+					d.Spec.Template.Spec.Volumes[k] = vol
+					add = false
+				}
+			}
+			if add {
+				d.Spec.Template.Spec.Volumes = append(d.Spec.Template.Spec.Volumes, vol)
+			}
+
+			volMount := corev1.VolumeMount{
+				Name:      s3CAVolName,
+				MountPath: s3CAPath,
+				ReadOnly:  true,
+			}
+			add = true
+			for k := range d.Spec.Template.Spec.Containers[i].VolumeMounts {
+				if d.Spec.Template.Spec.Containers[i].VolumeMounts[k].Name == s3CAVolName {
+					d.Spec.Template.Spec.Containers[i].VolumeMounts[k] = volMount
+					add = false
+				}
+			}
+			if add {
+				d.Spec.Template.Spec.Containers[i].VolumeMounts = append(
+					d.Spec.Template.Spec.Containers[i].VolumeMounts, volMount)
+			}
+			break
+		}
+
+		uObj, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(d)
+		if err != nil {
+			return err
+		}
+		u.SetUnstructuredContent(uObj)
+		return nil
+	}
+}
+
 // updates env keys with the secret name into "tekton-results-api" deployment in "api" container
 func updateEnvWithSecretName(props v1alpha1.ResultsAPIProperties) mf.Transformer {
 	return func(u *unstructured.Unstructured) error {
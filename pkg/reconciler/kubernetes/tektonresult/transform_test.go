@@ -183,6 +183,63 @@ func Test_GoogleCred(t *testing.T) {
 	}
 }
 
+func Test_S3Config(t *testing.T) {
+	manifest, err := mf.ManifestFrom(mf.Recursive(path.Join("testdata", "api-deployment.yaml")))
+	assert.NilError(t, err)
+
+	forcePathStyle := true
+	multiPartSize := int64(8 * 1024 * 1024)
+	result := v1alpha1.Result{
+		ResultsAPIProperties: v1alpha1.ResultsAPIProperties{LogsType: v1alpha1.LogsTypeS3},
+		S3: &v1alpha1.S3LogsProperties{
+			Endpoint:              "https://minio.minio.svc:9000",
+			Bucket:                "tekton-logs",
+			Region:                "us-east-1",
+			ForcePathStyle:        &forcePathStyle,
+			MultiPartSize:         &multiPartSize,
+			CredentialsSecretName: "s3-credentials",
+			CASecretName:          "minio-ca",
+			CASecretKey:           "ca.crt",
+		},
+	}
+
+	manifest, err = manifest.Transform(populateS3Config(result))
+	assert.NilError(t, err)
+
+	deployment := &appsv1.Deployment{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, deployment)
+	assert.NilError(t, err)
+
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, container.Name, apiContainerName)
+	envs := map[string]corev1.EnvVar{}
+	for _, env := range container.Env {
+		envs[env.Name] = env
+	}
+	assert.Equal(t, envs["S3_BUCKET_NAME"].Value, "tekton-logs")
+	assert.Equal(t, envs["S3_ENDPOINT"].Value, "https://minio.minio.svc:9000")
+	assert.Equal(t, envs["S3_REGION"].Value, "us-east-1")
+	assert.Equal(t, envs["S3_HOSTNAME_IMMUTABLE"].Value, "true")
+	assert.Equal(t, envs["S3_MULTI_PART_SIZE"].Value, "8388608")
+	assert.Equal(t, envs["S3_ACCESS_KEY_ID"].ValueFrom.SecretKeyRef.Name, "s3-credentials")
+	assert.Equal(t, envs["S3_SECRET_ACCESS_KEY"].ValueFrom.SecretKeyRef.Key, "S3_SECRET_ACCESS_KEY")
+	assert.Equal(t, envs[s3CABundleEnvName].Value, s3CAPath+"/ca.crt")
+
+	volumes := deployment.Spec.Template.Spec.Volumes
+	assert.Equal(t, volumes[len(volumes)-1].Name, s3CAVolName)
+	assert.Equal(t, volumes[len(volumes)-1].Secret.SecretName, "minio-ca")
+	mounts := container.VolumeMounts
+	assert.DeepEqual(t, mounts[len(mounts)-1], corev1.VolumeMount{Name: s3CAVolName, MountPath: s3CAPath, ReadOnly: true})
+
+	// applying the transformer again does not duplicate the volume
+	manifest, err = manifest.Transform(populateS3Config(result))
+	assert.NilError(t, err)
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(manifest.Resources()[0].Object, deployment)
+	assert.NilError(t, err)
+	assert.Equal(t, len(deployment.Spec.Template.Spec.Volumes), len(volumes))
+	assert.Equal(t, len(deployment.Spec.Template.Spec.Containers[0].Env), len(container.Env))
+}
+
 func TestUpdateEnvWithSecretName(t *testing.T) {
 	testData := path.Join("testdata", "api-deployment.yaml")
 	manifest, err := mf.ManifestFrom(mf.Recursive(testData))
@@ -18,10 +18,17 @@ package tektontrigger
 
 import (
 	"context"
+	"sync"
 
 	tektonInstallerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektoninstallerset"
 	tektonPipelineinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonpipeline"
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 
@@ -70,6 +77,7 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 
 		c := &Reconciler{
 			kubeClientSet:      kubeclient.Get(ctx),
+			dynamicClientSet:   dynamic.NewForConfigOrDie(injection.GetConfig(ctx)),
 			pipelineInformer:   tektonPipelineinformer.Get(ctx),
 			installerSetClient: client.NewInstallerSetClient(tisClient, operatorVer, triggersVer, v1alpha1.KindTektonTrigger, metrics),
 			extension:          generator(ctx),
@@ -91,6 +99,40 @@ func NewExtendedController(generator common.ExtensionGenerator) injection.Contro
 			logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 		}
 
+		// the EventListeners are watched once eventListenerTLS is enabled, the CRD of the EventListeners
+		// is not installed before
+		var watchEventListeners sync.Once
+		c.watchEventListeners = func() {
+			watchEventListeners.Do(func() {
+				eventListenerInformer := newEventListenerInformer(ctx, c.dynamicClientSet)
+				if _, err := eventListenerInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+					FilterFunc: func(interface{}) bool {
+						tt, err := tektonTriggerinformer.Get(ctx).Lister().Get(v1alpha1.TriggerResourceName)
+						return err == nil && tt.Spec.EventListenerTLS != nil
+					},
+					Handler: cache.ResourceEventHandlerFuncs{
+						AddFunc: func(interface{}) {
+							impl.EnqueueKey(types.NamespacedName{Name: v1alpha1.TriggerResourceName})
+						},
+					},
+				}); err != nil {
+					logger.Panicf("Couldn't register EventListener informer event handler: %w", err)
+				}
+				go eventListenerInformer.Run(ctx.Done())
+			})
+		}
+
 		return impl
 	}
 }
+
+func newEventListenerInformer(ctx context.Context, dynamicClientSet dynamic.Interface) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(&cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return dynamicClientSet.Resource(eventListenerGVR).List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return dynamicClientSet.Resource(eventListenerGVR).Watch(ctx, options)
+		},
+	}, &unstructured.Unstructured{}, 0, cache.Indexers{})
+}
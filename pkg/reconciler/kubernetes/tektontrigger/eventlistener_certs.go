@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektontrigger
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// eventListenerCAValidity is the validity of the operator managed CA,
+	// it is renewed, with all the certificates it signed, when it expires before a new certificate
+	eventListenerCAValidity = 10 * 365 * 24 * time.Hour
+	eventListenerCAKeyKey   = "ca.key"
+)
+
+// eventListenerCA is the operator managed CA signing the certificates of the EventListeners
+type eventListenerCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+}
+
+// newEventListenerCA generates a self signed CA
+func newEventListenerCA(now time.Time) (*eventListenerCA, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template, err := certTemplate(now, now.Add(eventListenerCAValidity))
+	if err != nil {
+		return nil, nil, err
+	}
+	template.Subject.CommonName = eventListenerCASecretName
+	template.IsCA = true
+	template.BasicConstraintsValid = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &eventListenerCA{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}, keyPEM, nil
+}
+
+// parseEventListenerCA reads the CA of the secret
+func parseEventListenerCA(secret *corev1.Secret) (*eventListenerCA, error) {
+	cert, err := decodeCert(secret.Data[corev1.ServiceAccountRootCAKey])
+	if err != nil {
+		return nil, err
+	}
+	keyBlock, _ := pem.Decode(secret.Data[eventListenerCAKeyKey])
+	if keyBlock == nil {
+		return nil, errors.New("failed to decode the CA key")
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &eventListenerCA{
+		cert:    cert,
+		key:     key,
+		certPEM: secret.Data[corev1.ServiceAccountRootCAKey],
+	}, nil
+}
+
+// issue signs a serving certificate for the dns names, valid for the duration
+func (ca *eventListenerCA) issue(dnsNames []string, now time.Time, duration time.Duration) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template, err := certTemplate(now, now.Add(duration))
+	if err != nil {
+		return nil, nil, err
+	}
+	template.Subject.CommonName = dnsNames[0]
+	template.DNSNames = dnsNames
+	template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err = encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), keyPEM, nil
+}
+
+// needsRenewal returns true if the certificate of the secret is not signed by the CA,
+// does not match the dns names, or expires within a third of the duration
+func (ca *eventListenerCA) needsRenewal(secret *corev1.Secret, dnsNames []string, now time.Time, duration time.Duration) bool {
+	if !bytes.Equal(secret.Data[corev1.ServiceAccountRootCAKey], ca.certPEM) {
+		return true
+	}
+	cert, err := decodeCert(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return true
+	}
+	if cert.CheckSignatureFrom(ca.cert) != nil || !slices.Equal(cert.DNSNames, dnsNames) {
+		return true
+	}
+	return cert.NotAfter.Sub(now) < duration/3
+}
+
+func certTemplate(notBefore, notAfter time.Time) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{Organization: []string{"tekton.dev"}},
+		// tolerate a clock skew with the clients
+		NotBefore: notBefore.Add(-5 * time.Minute),
+		NotAfter:  notAfter,
+	}, nil
+}
+
+func decodeCert(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode the certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func encodeKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
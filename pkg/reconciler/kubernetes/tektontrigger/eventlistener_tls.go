@@ -0,0 +1,503 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektontrigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// eventListenerCASecretName is the secret of the operator managed CA, in the target namespace
+	eventListenerCASecretName = "tekton-triggers-eventlistener-ca"
+	// eventListenerTLSLabel is set on the certificates and secrets, its value is the name of the EventListener
+	eventListenerTLSLabel = "operator.tekton.dev/eventlistener-tls"
+	// eventListenerTLSHashKey is set on the pod template of the EventListeners with the hash of the
+	// certificate, the EventListener is restarted when the certificate is renewed
+	eventListenerTLSHashKey = "operator.tekton.dev/eventlistener-tls-hash"
+	// eventListenerTLSResync is the period of the reconcile of the certificates, it renews the
+	// certificates signed by the operator managed CA
+	eventListenerTLSResync = 10 * time.Minute
+)
+
+var (
+	eventListenerGVR = schema.GroupVersionResource{Group: "triggers.tekton.dev", Version: "v1beta1", Resource: "eventlisteners"}
+	certificateGVR   = schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+)
+
+// reconcileEventListenerTLS issues a serving certificate for each EventListener of the selected
+// namespaces and mounts it in the EventListener, the certificates are renewed before they expire.
+// The EventListeners of the namespaces which are not selected anymore are served without TLS again.
+// The TLS env is set on the new EventListeners by the eventlistener admission webhook
+func (r *Reconciler) reconcileEventListenerTLS(ctx context.Context, tt *v1alpha1.TektonTrigger) error {
+	tls := tt.Spec.EventListenerTLS
+	if tls == nil {
+		// nothing to clean up if the EventListeners were never served with TLS
+		if !tt.Status.EventListenerTLS {
+			return nil
+		}
+		if err := r.cleanupEventListenerTLS(ctx, tt); err != nil {
+			return err
+		}
+		tt.Status.EventListenerTLS = false
+		return nil
+	}
+	tt.Status.EventListenerTLS = true
+
+	selector, err := metav1.LabelSelectorAsSelector(tls.NamespaceSelector)
+	if err != nil {
+		return err
+	}
+	namespaces, err := r.kubeClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+	selected := map[string]bool{}
+	for _, ns := range namespaces.Items {
+		// the EventListeners of a namespace being deleted are left alone
+		selected[ns.Name] = ns.DeletionTimestamp == nil
+	}
+
+	var ca *eventListenerCA
+	if tls.CertManager == nil {
+		if ca, err = r.ensureEventListenerCA(ctx, tt.Spec.GetTargetNamespace(), tls.Duration.Duration); err != nil {
+			return err
+		}
+	}
+
+	if r.watchEventListeners != nil {
+		r.watchEventListeners()
+	}
+
+	eventListeners, err := r.dynamicClientSet.Resource(eventListenerGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// the EventListener CRD is not installed yet
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	for i := range eventListeners.Items {
+		el := &eventListeners.Items[i]
+		active, found := selected[el.GetNamespace()]
+		switch {
+		case !found:
+			if err := r.releaseEventListenerCert(ctx, el); err != nil {
+				return fmt.Errorf("failed to remove the certificate of the EventListener %s/%s: %w",
+					el.GetNamespace(), el.GetName(), err)
+			}
+		case active:
+			if err := r.reconcileEventListenerCert(ctx, tls, ca, el); err != nil {
+				return fmt.Errorf("failed to reconcile the certificate of the EventListener %s/%s: %w",
+					el.GetNamespace(), el.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) reconcileEventListenerCert(ctx context.Context, tls *v1alpha1.EventListenerTLS, ca *eventListenerCA, el *unstructured.Unstructured) error {
+	var secret *corev1.Secret
+	var err error
+	if tls.CertManager != nil {
+		secret, err = r.ensureCertManagerCertificate(ctx, tls, el)
+	} else {
+		secret, err = r.ensureEventListenerCert(ctx, tls, ca, el)
+	}
+	if err != nil || secret == nil {
+		return err
+	}
+	return r.mountEventListenerCert(ctx, el, secret)
+}
+
+// ensureEventListenerCA returns the operator managed CA, it is created or renewed when it
+// expires before a certificate of the given duration
+func (r *Reconciler) ensureEventListenerCA(ctx context.Context, namespace string, duration time.Duration) (*eventListenerCA, error) {
+	secrets := r.kubeClientSet.CoreV1().Secrets(namespace)
+	now := time.Now()
+
+	existing, err := secrets.Get(ctx, eventListenerCASecretName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	found := err == nil
+	if found {
+		ca, err := parseEventListenerCA(existing)
+		if err == nil && ca.cert.NotAfter.After(now.Add(duration)) {
+			return ca, nil
+		}
+	}
+
+	logging.FromContext(ctx).Infow("creating the CA of the EventListener certificates", "secret", eventListenerCASecretName)
+	ca, keyPEM, err := newEventListenerCA(now)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{
+		corev1.ServiceAccountRootCAKey: ca.certPEM,
+		eventListenerCAKeyKey:          keyPEM,
+	}
+	if found {
+		existing.Data = data
+		_, err = secrets.Update(ctx, existing, metav1.UpdateOptions{})
+	} else {
+		_, err = secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: eventListenerCASecretName, Namespace: namespace},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       data,
+		}, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+// ensureEventListenerCert issues the certificate of the EventListener with the operator managed CA
+func (r *Reconciler) ensureEventListenerCert(ctx context.Context, tls *v1alpha1.EventListenerTLS, ca *eventListenerCA, el *unstructured.Unstructured) (*corev1.Secret, error) {
+	secrets := r.kubeClientSet.CoreV1().Secrets(el.GetNamespace())
+	name := common.EventListenerTLSSecretName(el.GetName())
+	dnsNames := eventListenerDNSNames(el)
+	now := time.Now()
+
+	existing, err := secrets.Get(ctx, name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	found := err == nil
+	if found && !ca.needsRenewal(existing, dnsNames, now, tls.Duration.Duration) {
+		return existing, nil
+	}
+
+	logging.FromContext(ctx).Infow("issuing the certificate of the EventListener",
+		"namespace", el.GetNamespace(), "eventListener", el.GetName())
+	certPEM, keyPEM, err := ca.issue(dnsNames, now, tls.Duration.Duration)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{
+		corev1.TLSCertKey:              certPEM,
+		corev1.TLSPrivateKeyKey:        keyPEM,
+		corev1.ServiceAccountRootCAKey: ca.certPEM,
+	}
+	if found {
+		existing.Data = data
+		return secrets.Update(ctx, existing, metav1.UpdateOptions{})
+	}
+	return secrets.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       el.GetNamespace(),
+			Labels:          map[string]string{eventListenerTLSLabel: el.GetName()},
+			OwnerReferences: []metav1.OwnerReference{eventListenerOwnerRef(el)},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: data,
+	}, metav1.CreateOptions{})
+}
+
+// ensureCertManagerCertificate creates the cert-manager Certificate of the EventListener and returns
+// its secret, or nil when the certificate is not issued yet. cert-manager renews the certificate
+func (r *Reconciler) ensureCertManagerCertificate(ctx context.Context, tls *v1alpha1.EventListenerTLS, el *unstructured.Unstructured) (*corev1.Secret, error) {
+	name := common.EventListenerTLSSecretName(el.GetName())
+	dnsNames := []interface{}{}
+	for _, dnsName := range eventListenerDNSNames(el) {
+		dnsNames = append(dnsNames, dnsName)
+	}
+	spec := map[string]interface{}{
+		"secretName":  name,
+		"dnsNames":    dnsNames,
+		"duration":    tls.Duration.Duration.String(),
+		"renewBefore": (tls.Duration.Duration / 3).String(),
+		"issuerRef": map[string]interface{}{
+			"name":  tls.CertManager.Name,
+			"kind":  tls.CertManager.Kind,
+			"group": certificateGVR.Group,
+		},
+		"privateKey": map[string]interface{}{
+			"rotationPolicy": "Always",
+		},
+	}
+	specHash, err := hash.Compute(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates := r.dynamicClientSet.Resource(certificateGVR).Namespace(el.GetNamespace())
+	existing, err := certificates.Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		certificate := &unstructured.Unstructured{}
+		certificate.SetAPIVersion(certificateGVR.GroupVersion().String())
+		certificate.SetKind("Certificate")
+		certificate.SetName(name)
+		certificate.SetNamespace(el.GetNamespace())
+		certificate.SetLabels(map[string]string{eventListenerTLSLabel: el.GetName()})
+		certificate.SetAnnotations(map[string]string{v1alpha1.LastAppliedHashKey: specHash})
+		certificate.SetOwnerReferences([]metav1.OwnerReference{eventListenerOwnerRef(el)})
+		certificate.Object["spec"] = spec
+		if _, err := certificates.Create(ctx, certificate, metav1.CreateOptions{}); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case existing.GetAnnotations()[v1alpha1.LastAppliedHashKey] != specHash:
+		annotations := existing.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[v1alpha1.LastAppliedHashKey] = specHash
+		existing.SetAnnotations(annotations)
+		existing.Object["spec"] = spec
+		if _, err := certificates.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+	}
+
+	secret, err := r.kubeClientSet.CoreV1().Secrets(el.GetNamespace()).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(secret.Data[corev1.TLSCertKey]) == 0 {
+		return nil, nil
+	}
+	return secret, nil
+}
+
+// mountEventListenerCert sets the TLS_CERT and TLS_KEY env of the EventListener from the secret,
+// Triggers then serves the EventListener with TLS. The hash of the certificate is set on the pod
+// template, so that the EventListener is restarted when the certificate is renewed
+func (r *Reconciler) mountEventListenerCert(ctx context.Context, el *unstructured.Unstructured, secret *corev1.Secret) error {
+	listener := &v1beta1.EventListener{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(el.Object, listener); err != nil {
+		return err
+	}
+	if listener.Spec.Resources.CustomResource != nil {
+		logging.FromContext(ctx).Warnw("EventListener with a custom resource, the certificate is not mounted",
+			"namespace", el.GetNamespace(), "eventListener", el.GetName())
+		return nil
+	}
+
+	certHash, err := hash.Compute(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return err
+	}
+
+	var template v1beta1.KubernetesResource
+	if listener.Spec.Resources.KubernetesResource != nil {
+		template = *listener.Spec.Resources.KubernetesResource
+	}
+	containers := template.Template.Spec.Containers
+	if len(containers) == 0 {
+		containers = []corev1.Container{{}}
+	} else {
+		containers = append([]corev1.Container{}, containers...)
+		containers[0] = *containers[0].DeepCopy()
+	}
+	containers[0].Env = common.MergeEventListenerTLSEnv(containers[0].Env, el.GetName())
+
+	if template.Template.Annotations[eventListenerTLSHashKey] == certHash &&
+		reflect.DeepEqual(containers, template.Template.Spec.Containers) {
+		return nil
+	}
+	return r.patchEventListenerTemplate(ctx, el, certHash, containers)
+}
+
+// cleanupEventListenerTLS serves the EventListeners without TLS again when eventListenerTLS is not set,
+// and deletes their certificates and the operator managed CA
+func (r *Reconciler) cleanupEventListenerTLS(ctx context.Context, tt *v1alpha1.TektonTrigger) error {
+	eventListeners, err := r.dynamicClientSet.Resource(eventListenerGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		for i := range eventListeners.Items {
+			if _, err := r.unmountEventListenerCert(ctx, &eventListeners.Items[i]); err != nil {
+				return fmt.Errorf("failed to unmount the certificate of the EventListener %s/%s: %w",
+					eventListeners.Items[i].GetNamespace(), eventListeners.Items[i].GetName(), err)
+			}
+		}
+	}
+
+	listOptions := metav1.ListOptions{LabelSelector: eventListenerTLSLabel}
+	certificates, err := r.dynamicClientSet.Resource(certificateGVR).Namespace(metav1.NamespaceAll).List(ctx, listOptions)
+	// the Certificate CRD is not installed when cert-manager is not used
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		for _, certificate := range certificates.Items {
+			logging.FromContext(ctx).Infow("deleting the certificate of the EventListener",
+				"namespace", certificate.GetNamespace(), "eventListener", certificate.GetLabels()[eventListenerTLSLabel])
+			err := r.dynamicClientSet.Resource(certificateGVR).Namespace(certificate.GetNamespace()).
+				Delete(ctx, certificate.GetName(), metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			// the secret issued by cert-manager is not labelled by the operator
+			secretName, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+			if secretName == "" {
+				continue
+			}
+			err = r.kubeClientSet.CoreV1().Secrets(certificate.GetNamespace()).Delete(ctx, secretName, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	secrets, err := r.kubeClientSet.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets.Items {
+		logging.FromContext(ctx).Infow("deleting the certificate of the EventListener",
+			"namespace", secret.Namespace, "eventListener", secret.Labels[eventListenerTLSLabel])
+		err := r.kubeClientSet.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	err = r.kubeClientSet.CoreV1().Secrets(tt.Spec.GetTargetNamespace()).Delete(ctx, eventListenerCASecretName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// releaseEventListenerCert serves an EventListener of a namespace which is not selected anymore
+// without TLS again, and deletes its certificate
+func (r *Reconciler) releaseEventListenerCert(ctx context.Context, el *unstructured.Unstructured) error {
+	unmounted, err := r.unmountEventListenerCert(ctx, el)
+	if err != nil || !unmounted {
+		return err
+	}
+	name := common.EventListenerTLSSecretName(el.GetName())
+	err = r.dynamicClientSet.Resource(certificateGVR).Namespace(el.GetNamespace()).Delete(ctx, name, metav1.DeleteOptions{})
+	// the Certificate CRD is not installed when cert-manager is not used
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	err = r.kubeClientSet.CoreV1().Secrets(el.GetNamespace()).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// unmountEventListenerCert removes the TLS env and the hash of the certificate from an EventListener
+// served with a certificate of the operator, it returns true if the certificate was mounted
+func (r *Reconciler) unmountEventListenerCert(ctx context.Context, el *unstructured.Unstructured) (bool, error) {
+	listener := &v1beta1.EventListener{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(el.Object, listener); err != nil {
+		return false, err
+	}
+	if listener.Spec.Resources.KubernetesResource == nil {
+		return false, nil
+	}
+	template := listener.Spec.Resources.KubernetesResource.Template
+	_, mounted := template.Annotations[eventListenerTLSHashKey]
+	if len(template.Spec.Containers) > 0 {
+		for _, env := range template.Spec.Containers[0].Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil &&
+				env.ValueFrom.SecretKeyRef.Name == common.EventListenerTLSSecretName(el.GetName()) {
+				mounted = true
+			}
+		}
+	}
+	if !mounted {
+		return false, nil
+	}
+
+	logging.FromContext(ctx).Infow("unmounting the certificate of the EventListener",
+		"namespace", el.GetNamespace(), "eventListener", el.GetName())
+	containers := append([]corev1.Container{}, template.Spec.Containers...)
+	if len(containers) > 0 {
+		containers[0] = *containers[0].DeepCopy()
+		containers[0].Env = common.RemoveEventListenerTLSEnv(containers[0].Env)
+	}
+	return true, r.patchEventListenerTemplate(ctx, el, nil, containers)
+}
+
+// patchEventListenerTemplate sets the containers and the hash of the certificate of the pod template
+// of the EventListener, a nil hash removes the annotation
+func (r *Reconciler) patchEventListenerTemplate(ctx context.Context, el *unstructured.Unstructured, certHash interface{}, containers []corev1.Container) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"kubernetesResource": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"metadata": map[string]interface{}{
+								"annotations": map[string]interface{}{eventListenerTLSHashKey: certHash},
+							},
+							"spec": map[string]interface{}{
+								"containers": containers,
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.dynamicClientSet.Resource(eventListenerGVR).Namespace(el.GetNamespace()).
+		Patch(ctx, el.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// eventListenerDNSNames returns the names of the service of the EventListener
+func eventListenerDNSNames(el *unstructured.Unstructured) []string {
+	service := "el-" + el.GetName()
+	return []string{
+		service,
+		service + "." + el.GetNamespace(),
+		service + "." + el.GetNamespace() + ".svc",
+		service + "." + el.GetNamespace() + ".svc.cluster.local",
+	}
+}
+
+func eventListenerOwnerRef(el *unstructured.Unstructured) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: el.GetAPIVersion(),
+		Kind:       el.GetKind(),
+		Name:       el.GetName(),
+		UID:        el.GetUID(),
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektontrigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testEventListener(namespace, name string) *unstructured.Unstructured {
+	el := &unstructured.Unstructured{}
+	el.SetAPIVersion("triggers.tekton.dev/v1beta1")
+	el.SetKind("EventListener")
+	el.SetNamespace(namespace)
+	el.SetName(name)
+	el.Object["spec"] = map[string]interface{}{"serviceAccountName": "triggers"}
+	return el
+}
+
+func newEventListenerTLSReconciler(objs ...runtime.Object) *Reconciler {
+	kubeClient := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "ci"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+	)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			eventListenerGVR: "EventListenerList",
+			certificateGVR:   "CertificateList",
+		},
+		objs...)
+	return &Reconciler{kubeClientSet: kubeClient, dynamicClientSet: dynamicClient}
+}
+
+func testTriggerWithTLS(tls *v1alpha1.EventListenerTLS) *v1alpha1.TektonTrigger {
+	tt := &v1alpha1.TektonTrigger{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.TriggerResourceName},
+		Spec: v1alpha1.TektonTriggerSpec{
+			CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Trigger:    v1alpha1.Trigger{EventListenerTLS: tls},
+		},
+	}
+	tt.SetDefaults(context.TODO())
+	return tt
+}
+
+func getEventListener(t *testing.T, r *Reconciler, namespace, name string) *v1beta1.EventListener {
+	t.Helper()
+	u, err := r.dynamicClientSet.Resource(eventListenerGVR).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	assert.NilError(t, err)
+	el := &v1beta1.EventListener{}
+	assert.NilError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, el))
+	return el
+}
+
+func TestReconcileEventListenerTLS_OperatorCA(t *testing.T) {
+	ctx := context.TODO()
+	r := newEventListenerTLSReconciler(testEventListener("team-a", "github"), testEventListener("team-b", "gitlab"))
+	tt := testTriggerWithTLS(&v1alpha1.EventListenerTLS{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ci"}},
+	})
+
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+
+	caSecret, err := r.kubeClientSet.CoreV1().Secrets("tekton-pipelines").Get(ctx, eventListenerCASecretName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	ca, err := parseEventListenerCA(caSecret)
+	assert.NilError(t, err)
+
+	secret, err := r.kubeClientSet.CoreV1().Secrets("team-a").Get(ctx, "el-github-tls", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, secret.Type, corev1.SecretTypeTLS)
+	assert.Equal(t, secret.OwnerReferences[0].Kind, "EventListener")
+	cert, err := decodeCert(secret.Data[corev1.TLSCertKey])
+	assert.NilError(t, err)
+	assert.NilError(t, cert.CheckSignatureFrom(ca.cert))
+	assert.DeepEqual(t, cert.DNSNames, []string{
+		"el-github", "el-github.team-a", "el-github.team-a.svc", "el-github.team-a.svc.cluster.local",
+	})
+
+	el := getEventListener(t, r, "team-a", "github")
+	template := el.Spec.Resources.KubernetesResource.Template
+	assert.DeepEqual(t, template.Spec.Containers[0].Env, common.EventListenerTLSEnv("github"))
+	certHash := template.Annotations[eventListenerTLSHashKey]
+	assert.Assert(t, certHash != "")
+	assert.Equal(t, el.Spec.ServiceAccountName, "triggers")
+
+	// the EventListeners of the other namespaces are not changed
+	_, err = r.kubeClientSet.CoreV1().Secrets("team-b").Get(ctx, "el-gitlab-tls", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	assert.Assert(t, getEventListener(t, r, "team-b", "gitlab").Spec.Resources.KubernetesResource == nil)
+
+	// a valid certificate is kept
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+	unchanged, err := r.kubeClientSet.CoreV1().Secrets("team-a").Get(ctx, "el-github-tls", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, unchanged.Data, secret.Data)
+
+	// a certificate not signed by the current CA is renewed, and the EventListener restarted
+	secret.Data[corev1.ServiceAccountRootCAKey] = []byte("previous CA")
+	_, err = r.kubeClientSet.CoreV1().Secrets("team-a").Update(ctx, secret, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+	renewed, err := r.kubeClientSet.CoreV1().Secrets("team-a").Get(ctx, "el-github-tls", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, renewed.Data[corev1.ServiceAccountRootCAKey], ca.certPEM)
+	el = getEventListener(t, r, "team-a", "github")
+	assert.Assert(t, el.Spec.Resources.KubernetesResource.Template.Annotations[eventListenerTLSHashKey] != certHash)
+	assert.Equal(t, len(el.Spec.Resources.KubernetesResource.Template.Spec.Containers[0].Env), 2)
+}
+
+func TestReconcileEventListenerTLS_CertManager(t *testing.T) {
+	ctx := context.TODO()
+	r := newEventListenerTLSReconciler(testEventListener("team-a", "github"))
+	tt := testTriggerWithTLS(&v1alpha1.EventListenerTLS{
+		NamespaceSelector: &metav1.LabelSelector{},
+		CertManager:       &v1alpha1.CertManagerIssuerRef{Name: "letsencrypt"},
+	})
+
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+
+	certificate, err := r.dynamicClientSet.Resource(certificateGVR).Namespace("team-a").Get(ctx, "el-github-tls", metav1.GetOptions{})
+	assert.NilError(t, err)
+	issuerKind, _, _ := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "kind")
+	assert.Equal(t, issuerKind, v1alpha1.CertManagerClusterIssuer)
+	duration, _, _ := unstructured.NestedString(certificate.Object, "spec", "duration")
+	assert.Equal(t, duration, "2160h0m0s")
+	renewBefore, _, _ := unstructured.NestedString(certificate.Object, "spec", "renewBefore")
+	assert.Equal(t, renewBefore, "720h0m0s")
+
+	// the operator managed CA is not created
+	_, err = r.kubeClientSet.CoreV1().Secrets("tekton-pipelines").Get(ctx, eventListenerCASecretName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	// the EventListener is changed once cert-manager issued the certificate
+	assert.Assert(t, getEventListener(t, r, "team-a", "github").Spec.Resources.KubernetesResource == nil)
+	_, err = r.kubeClientSet.CoreV1().Secrets("team-a").Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "el-github-tls", Namespace: "team-a"},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")},
+	}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+	el := getEventListener(t, r, "team-a", "github")
+	assert.DeepEqual(t, el.Spec.Resources.KubernetesResource.Template.Spec.Containers[0].Env, common.EventListenerTLSEnv("github"))
+
+	// the certificate is updated with the issuer
+	tt.Spec.EventListenerTLS.CertManager.Name = "internal-ca"
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+	certificate, err = r.dynamicClientSet.Resource(certificateGVR).Namespace("team-a").Get(ctx, "el-github-tls", metav1.GetOptions{})
+	assert.NilError(t, err)
+	issuerName, _, _ := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "name")
+	assert.Equal(t, issuerName, "internal-ca")
+}
+
+func TestReconcileEventListenerTLS_Disabled(t *testing.T) {
+	ctx := context.TODO()
+	userEnv := corev1.EnvVar{Name: "LOG_LEVEL", Value: "debug"}
+	github := testEventListener("team-a", "github")
+	github.Object["spec"].(map[string]interface{})["resources"] = map[string]interface{}{
+		"kubernetesResource": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{map[string]interface{}{
+							"env": []interface{}{map[string]interface{}{"name": userEnv.Name, "value": userEnv.Value}},
+						}},
+					},
+				},
+			},
+		},
+	}
+	r := newEventListenerTLSReconciler(github)
+	tt := testTriggerWithTLS(&v1alpha1.EventListenerTLS{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ci"}},
+	})
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+	template := getEventListener(t, r, "team-a", "github").Spec.Resources.KubernetesResource.Template
+	assert.Equal(t, len(template.Spec.Containers[0].Env), 3)
+
+	tt.Spec.EventListenerTLS = nil
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+
+	template = getEventListener(t, r, "team-a", "github").Spec.Resources.KubernetesResource.Template
+	assert.DeepEqual(t, template.Spec.Containers[0].Env, []corev1.EnvVar{userEnv})
+	_, found := template.Annotations[eventListenerTLSHashKey]
+	assert.Assert(t, !found)
+	_, err := r.kubeClientSet.CoreV1().Secrets("team-a").Get(ctx, "el-github-tls", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	_, err = r.kubeClientSet.CoreV1().Secrets("tekton-pipelines").Get(ctx, eventListenerCASecretName, metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+	assert.Equal(t, tt.Status.EventListenerTLS, false)
+}
+
+func TestReconcileEventListenerTLS_NeverEnabled(t *testing.T) {
+	r := newEventListenerTLSReconciler(testEventListener("team-a", "github"))
+	tt := testTriggerWithTLS(nil)
+	assert.NilError(t, r.reconcileEventListenerTLS(context.TODO(), tt))
+
+	// nothing is listed on the cluster when the EventListeners were never served with TLS
+	assert.Equal(t, len(r.dynamicClientSet.(*dynamicfake.FakeDynamicClient).Actions()), 0)
+	assert.Equal(t, len(r.kubeClientSet.(*fake.Clientset).Actions()), 0)
+}
+
+func TestReconcileEventListenerTLS_NamespaceNotSelected(t *testing.T) {
+	ctx := context.TODO()
+	r := newEventListenerTLSReconciler(testEventListener("team-a", "github"))
+	tt := testTriggerWithTLS(&v1alpha1.EventListenerTLS{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ci"}},
+	})
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+	template := getEventListener(t, r, "team-a", "github").Spec.Resources.KubernetesResource.Template
+	assert.Equal(t, len(template.Spec.Containers[0].Env), 2)
+
+	// team-a drops out of the namespaceSelector
+	_, err := r.kubeClientSet.CoreV1().Namespaces().Update(ctx,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, r.reconcileEventListenerTLS(ctx, tt))
+
+	template = getEventListener(t, r, "team-a", "github").Spec.Resources.KubernetesResource.Template
+	assert.Equal(t, len(template.Spec.Containers[0].Env), 0)
+	_, found := template.Annotations[eventListenerTLSHashKey]
+	assert.Assert(t, !found)
+	_, err = r.kubeClientSet.CoreV1().Secrets("team-a").Get(ctx, "el-github-tls", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
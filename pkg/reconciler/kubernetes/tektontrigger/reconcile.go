@@ -28,8 +28,10 @@ import (
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
@@ -40,6 +42,8 @@ const resourceKind = v1alpha1.KindTektonTrigger
 type Reconciler struct {
 	// kube client to interact with core k8s resources
 	kubeClientSet kubernetes.Interface
+	// dynamic client to interact with the EventListeners and cert-manager Certificates
+	dynamicClientSet dynamic.Interface
 	// installer Set client to do CRUD operations for components
 	installerSetClient *client.InstallerSetClient
 	// pipelineInformer to query for TektonPipeline
@@ -51,6 +55,9 @@ type Reconciler struct {
 	extension common.Extension
 	// version of triggers which we are installing
 	triggersVersion string
+	// watchEventListeners starts the watch of the EventListeners, the certificates of the new
+	// EventListeners are then issued when they are created
+	watchEventListeners func()
 }
 
 // Check that our Reconciler implements controller.Reconciler
@@ -129,7 +136,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tt *v1alpha1.TektonTrigg
 
 	// Ensure webhook deadlock prevention before applying the manifest
 	logger.Debugw("Preventing webhook deadlock")
-	if err := common.PreemptDeadlock(ctx, &r.manifest, r.kubeClientSet, v1alpha1.TriggerResourceName); err != nil {
+	if err := common.PreemptDeadlock(ctx, &r.manifest, r.kubeClientSet, tt); err != nil {
 		logger.Error("Webhook deadlock prevention failed", "error", err)
 		return err
 	}
@@ -162,10 +169,18 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tt *v1alpha1.TektonTrigg
 
 	// Mark PostReconcile Complete
 	tt.Status.MarkPostReconcilerComplete()
+
+	if err := r.reconcileEventListenerTLS(ctx, tt); err != nil {
+		logger.Errorw("Failed to reconcile the EventListener certificates", "error", err)
+		return err
+	}
 	logger.Infow("TektonTrigger reconciliation completed successfully",
 		"ready", tt.Status.GetCondition(apis.ConditionReady).IsTrue(),
 		"version", r.triggersVersion)
 
+	if tt.Spec.EventListenerTLS != nil {
+		return controller.NewRequeueAfter(eventListenerTLSResync)
+	}
 	return nil
 }
 
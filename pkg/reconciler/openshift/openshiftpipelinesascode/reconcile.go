@@ -29,6 +29,7 @@ import (
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/kubernetes"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
@@ -42,6 +43,8 @@ const (
 type Reconciler struct {
 	// installer Set client to do CRUD operations for components
 	installerSetClient *client.InstallerSetClient
+	// kubeClientSet allows us to talk to the k8s for core APIs
+	kubeClientSet kubernetes.Interface
 	// pipelineInformer to query for TektonPipeline
 	pipelineInformer pipelineinformer.TektonPipelineInformer
 	// manifest has the source manifest of Openshift Pipelines As Code for a
@@ -95,6 +98,12 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, pac *v1alpha1.OpenShiftP
 	//Mark PreReconcile Complete
 	pac.Status.MarkPreReconcilerComplete()
 
+	// Ensure webhook deadlock prevention before applying the manifest
+	if err := common.PreemptDeadlock(ctx, &r.manifest, r.kubeClientSet, pac); err != nil {
+		logger.Errorw("Failed to preempt webhook deadlock", "error", err)
+		return err
+	}
+
 	if err := r.installerSetClient.MainSet(ctx, pac, &r.manifest, filterAndTransform(r.extension)); err != nil {
 		msg := fmt.Sprintf("Main Reconcilation failed: %s", err.Error())
 		logger.Error(msg)
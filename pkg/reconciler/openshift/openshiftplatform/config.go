@@ -33,7 +33,6 @@ import (
 )
 
 const (
-	ControllerTektonAddon              platform.ControllerName = "tektonaddon"
 	ControllerOpenShiftPipelinesAsCode platform.ControllerName = "openshiftpipelinesascode"
 	PlatformNameOpenShift              string                  = "openshift"
 )
@@ -70,8 +69,8 @@ var (
 			Name:                  string(platform.ControllerManualApprovalGate),
 			ControllerConstructor: openshiftManualApprovalGate.NewController,
 		},
-		ControllerTektonAddon: injection.NamedControllerConstructor{
-			Name:                  string(ControllerTektonAddon),
+		platform.ControllerTektonAddon: injection.NamedControllerConstructor{
+			Name:                  string(platform.ControllerTektonAddon),
 			ControllerConstructor: openshiftAddon.NewController,
 		},
 		ControllerOpenShiftPipelinesAsCode: injection.NamedControllerConstructor{
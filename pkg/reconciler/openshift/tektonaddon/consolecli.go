@@ -28,8 +28,8 @@ import (
 	"knative.dev/pkg/logging"
 )
 
-func (r *Reconciler) EnsureConsoleCLI(ctx context.Context, ta *v1alpha1.TektonAddon) error {
-	tknservecliManifest := r.openShiftConsoleManifest
+func (oe openshiftExtension) EnsureConsoleCLI(ctx context.Context, ta *v1alpha1.TektonAddon) error {
+	tknservecliManifest := oe.openShiftConsoleManifest
 	if err := common.Transform(ctx, tknservecliManifest, ta); err != nil {
 		return err
 	}
@@ -37,11 +37,11 @@ func (r *Reconciler) EnsureConsoleCLI(ctx context.Context, ta *v1alpha1.TektonAd
 	if err != nil {
 		return err
 	}
-	manifest := *r.consoleCLIManifest
+	manifest := *oe.consoleCLIManifest
 	if err := consoleCLITransform(ctx, &manifest, routeHost); err != nil {
 		return err
 	}
-	if err := r.installerSetClient.CustomSet(ctx, ta, ConsoleCLIInstallerSet, &manifest, filterAndTransformOCPResources(), nil); err != nil {
+	if err := oe.installerSetClient.CustomSet(ctx, ta, ConsoleCLIInstallerSet, &manifest, filterAndTransformOCPResources(), nil); err != nil {
 		return err
 	}
 	return nil
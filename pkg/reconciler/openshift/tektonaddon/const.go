@@ -17,20 +17,11 @@ limitations under the License.
 package tektonaddon
 
 const (
-	ResolverTaskInstallerSet                = "ResolverTask"
-	ResolverStepActionInstallerSet          = "ResolverStepAction"
-	OpenShiftConsoleInstallerSet            = "OpenShiftConsole"
-	VersionedResolverTaskInstallerSet       = "VersionedResolverTask"
-	VersionedResolverStepActionInstallerSet = "VersionedResolverStepAction"
-	CommunityResolverTaskInstallerSet       = "CommunityResolverTask"
-	versionedClusterTaskPatchChar           = "0"
-	PipelinesTemplateInstallerSet           = "PipelinesTemplate"
-	TriggersResourcesInstallerSet           = "TriggersResources"
-	ConsoleCLIInstallerSet                  = "ConsoleCLI"
-	ConsoleHubLinkInstallerSet              = "ConsoleHub"
-	MiscellaneousResourcesInstallerSet      = "MiscellaneousResources"
-	PACInstallerSet                         = "PipelinesAsCode"
-	CreatedByValue                          = "TektonAddon"
-	KindTask                                = "Task"
-	KindStepAction                          = "StepAction"
+	OpenShiftConsoleInstallerSet       = "OpenShiftConsole"
+	PipelinesTemplateInstallerSet      = "PipelinesTemplate"
+	TriggersResourcesInstallerSet      = "TriggersResources"
+	ConsoleCLIInstallerSet             = "ConsoleCLI"
+	ConsoleHubLinkInstallerSet         = "ConsoleHub"
+	MiscellaneousResourcesInstallerSet = "MiscellaneousResources"
+	PACInstallerSet                    = "PipelinesAsCode"
 )
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonaddon
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	mfc "github.com/manifestival/client-go-client"
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	informer "github.com/tektoncd/operator/pkg/client/informers/externalversions/operator/v1alpha1"
+	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	tektonTriggerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektontrigger"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	k8sAddon "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonaddon"
+	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
+	"github.com/tektoncd/operator/pkg/reconciler/openshift"
+	"go.uber.org/zap"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+)
+
+// OpenShiftExtension installs the OpenShift specific addons: the cluster trigger bindings,
+// the pipeline templates, the console resources and the console cli downloads
+func OpenShiftExtension(ctx context.Context) common.Extension {
+	logger := logging.FromContext(ctx)
+
+	version := os.Getenv(v1alpha1.VersionEnvKey)
+	if version == "" {
+		logger.Fatal("Failed to find version from env")
+	}
+
+	mfclient, err := mfc.NewClient(injection.GetConfig(ctx))
+	if err != nil {
+		logger.Fatalw("Error creating client from injected config", zap.Error(err))
+	}
+	crdClient, err := apiextensionsclient.NewForConfig(injection.GetConfig(ctx))
+	if err != nil {
+		logger.Fatalw("Error creating client from injected config", zap.Error(err))
+	}
+
+	triggersResourcesManifest := &mf.Manifest{}
+	if err := k8sAddon.ApplyAddons(triggersResourcesManifest, "01-clustertriggerbindings"); err != nil {
+		logger.Fatalf("failed to read trigger Resources from kodata: %v", err)
+	}
+
+	pipelineTemplateManifest := &mf.Manifest{}
+	if err := k8sAddon.ApplyAddons(pipelineTemplateManifest, "02-pipelines"); err != nil {
+		logger.Fatalf("failed to read pipeline template from kodata: %v", err)
+	}
+	if err := addPipelineTemplates(pipelineTemplateManifest); err != nil {
+		logger.Fatalf("failed to add pipeline templates: %v", err)
+	}
+
+	openShiftConsoleManifest := &mf.Manifest{Client: mfclient}
+	if err := k8sAddon.ApplyAddons(openShiftConsoleManifest, "04-tkncliserve"); err != nil {
+		logger.Fatalf("failed to read openshift console resources from kodata: %v", err)
+	}
+	if err := getOptionalAddons(openShiftConsoleManifest); err != nil {
+		logger.Fatalf("failed to read optional addon resources from kodata: %v", err)
+	}
+
+	consoleCLIManifest := &mf.Manifest{}
+	if err := k8sAddon.ApplyAddons(consoleCLIManifest, "03-consolecli"); err != nil {
+		logger.Fatalf("failed to read console cli from kodata: %v", err)
+	}
+
+	// the sets keep the prefix and the kind of the sets created by the addon reconciler,
+	// so that the sets created before the split into a platform extension are adopted
+	tisClient := operatorclient.Get(ctx).OperatorV1alpha1().TektonInstallerSets()
+	return &openshiftExtension{
+		installerSetClient:        client.NewInstallerSetClient(tisClient, version, "addon", v1alpha1.KindTektonAddon, nil),
+		crdClientSet:              crdClient,
+		triggerInformer:           tektonTriggerinformer.Get(ctx),
+		triggersResourcesManifest: triggersResourcesManifest,
+		pipelineTemplateManifest:  pipelineTemplateManifest,
+		openShiftConsoleManifest:  openShiftConsoleManifest,
+		consoleCLIManifest:        consoleCLIManifest,
+	}
+}
+
+type openshiftExtension struct {
+	installerSetClient *client.InstallerSetClient
+	// crdClientSet allows us to talk to the k8s for core APIs
+	crdClientSet              *apiextensionsclient.Clientset
+	triggerInformer           informer.TektonTriggerInformer
+	triggersResourcesManifest *mf.Manifest
+	pipelineTemplateManifest  *mf.Manifest
+	openShiftConsoleManifest  *mf.Manifest
+	consoleCLIManifest        *mf.Manifest
+}
+
+func (oe openshiftExtension) Transformers(comp v1alpha1.TektonComponent) []mf.Transformer {
+	return []mf.Transformer{
+		common.InjectOperandNameLabelPreserveExisting(openshift.OperandOpenShiftPipelinesAddons),
+	}
+}
+
+func (oe openshiftExtension) PreReconcile(ctx context.Context, comp v1alpha1.TektonComponent) error {
+	ta := comp.(*v1alpha1.TektonAddon)
+
+	// Make sure TektonTrigger is installed before proceeding with
+	// the trigger resources
+	if _, err := common.TriggerReady(oe.triggerInformer); err != nil {
+		if err.Error() == common.TriggerNotReady || err == v1alpha1.DEPENDENCY_UPGRADE_PENDING_ERR {
+			ta.Status.MarkDependencyInstalling("tekton-triggers is still installing")
+			// wait for trigger status to change
+			return v1alpha1.REQUEUE_EVENT_AFTER
+		}
+		// (tektontrigger.operator.tekton.dev instance not available yet)
+		ta.Status.MarkDependencyMissing("tekton-triggers does not exist")
+		return err
+	}
+	return nil
+}
+
+func (oe openshiftExtension) PostReconcile(ctx context.Context, comp v1alpha1.TektonComponent) error {
+	logger := logging.FromContext(ctx)
+	ta := comp.(*v1alpha1.TektonAddon)
+	ptVal, _ := k8sAddon.FindValue(ta.Spec.Params, v1alpha1.PipelineTemplatesParam)
+
+	var lastErr error
+	if err := oe.EnsurePipelineTemplates(ctx, ptVal, ta); err != nil {
+		lastErr = fmt.Errorf("pipelines templates not yet ready:  %v", err)
+		logger.Error(lastErr)
+	}
+
+	if err := oe.EnsureTriggersResources(ctx, ta); err != nil {
+		lastErr = fmt.Errorf("triggers resources not yet ready:  %v", err)
+		logger.Error(lastErr)
+	}
+
+	err, consoleCLIDownloadExist := oe.EnsureOpenShiftConsoleResources(ctx, ta)
+	if err != nil {
+		lastErr = fmt.Errorf("openshift console resources not yet ready:  %v", err)
+		logger.Error(lastErr)
+	}
+
+	if consoleCLIDownloadExist {
+		if err := oe.EnsureConsoleCLI(ctx, ta); err != nil {
+			lastErr = fmt.Errorf("console cli not yet ready:  %v", err)
+			logger.Error(lastErr)
+		}
+	}
+	return lastErr
+}
+
+// Finalize has nothing to do, the addon reconciler cleans up all the custom sets
+// of TektonAddon including the ones of the extension
+func (oe openshiftExtension) Finalize(context.Context, v1alpha1.TektonComponent) error {
+	return nil
+}
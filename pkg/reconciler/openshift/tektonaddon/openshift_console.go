@@ -29,9 +29,9 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func (r *Reconciler) EnsureOpenShiftConsoleResources(ctx context.Context, ta *v1alpha1.TektonAddon) (error, bool) {
-	filteredManifest := *r.openShiftConsoleManifest
-	consoleYamlSampleExist, err := r.checkCRDExist(ctx, "consoleyamlsamples.console.openshift.io")
+func (oe openshiftExtension) EnsureOpenShiftConsoleResources(ctx context.Context, ta *v1alpha1.TektonAddon) (error, bool) {
+	filteredManifest := *oe.openShiftConsoleManifest
+	consoleYamlSampleExist, err := oe.checkCRDExist(ctx, "consoleyamlsamples.console.openshift.io")
 	if err != nil {
 		return err, true
 	}
@@ -39,7 +39,7 @@ func (r *Reconciler) EnsureOpenShiftConsoleResources(ctx context.Context, ta *v1
 		filteredManifest = filteredManifest.Filter(mf.Not(mf.ByKind("ConsoleYAMLSample")))
 	}
 
-	consoleQuickStartExist, err := r.checkCRDExist(ctx, "consolequickstarts.console.openshift.io")
+	consoleQuickStartExist, err := oe.checkCRDExist(ctx, "consolequickstarts.console.openshift.io")
 	if err != nil {
 		return err, true
 	}
@@ -47,7 +47,7 @@ func (r *Reconciler) EnsureOpenShiftConsoleResources(ctx context.Context, ta *v1
 		filteredManifest = filteredManifest.Filter(mf.Not(mf.ByKind("ConsoleQuickStart")))
 	}
 
-	consoleCLIDownloadExist, err := r.checkCRDExist(ctx, "consoleclidownloads.console.openshift.io")
+	consoleCLIDownloadExist, err := oe.checkCRDExist(ctx, "consoleclidownloads.console.openshift.io")
 	if err != nil {
 		return err, true
 	}
@@ -58,14 +58,14 @@ func (r *Reconciler) EnsureOpenShiftConsoleResources(ctx context.Context, ta *v1
 	if len(filteredManifest.Resources()) == 0 {
 		return nil, consoleCLIDownloadExist
 	}
-	if err := r.installerSetClient.CustomSet(ctx, ta, OpenShiftConsoleInstallerSet, &filteredManifest, filterAndTransformOCPResources(), nil); err != nil {
+	if err := oe.installerSetClient.CustomSet(ctx, ta, OpenShiftConsoleInstallerSet, &filteredManifest, filterAndTransformOCPResources(), nil); err != nil {
 		return err, consoleCLIDownloadExist
 	}
 	return nil, consoleCLIDownloadExist
 }
 
-func (r *Reconciler) checkCRDExist(ctx context.Context, crdName string) (bool, error) {
-	_, err := r.crdClientSet.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, crdName, v1.GetOptions{})
+func (oe openshiftExtension) checkCRDExist(ctx context.Context, crdName string) (bool, error) {
+	_, err := oe.crdClientSet.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, crdName, v1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil
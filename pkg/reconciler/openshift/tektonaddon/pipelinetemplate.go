@@ -27,14 +27,14 @@ import (
 	tektonaddon "github.com/tektoncd/operator/pkg/reconciler/openshift/tektonaddon/pipelinetemplates"
 )
 
-func (r *Reconciler) EnsurePipelineTemplates(ctx context.Context, enable string, ta *v1alpha1.TektonAddon) error {
-	manifest := *r.pipelineTemplateManifest
+func (oe openshiftExtension) EnsurePipelineTemplates(ctx context.Context, enable string, ta *v1alpha1.TektonAddon) error {
+	manifest := *oe.pipelineTemplateManifest
 	if enable == "true" {
-		if err := r.installerSetClient.CustomSet(ctx, ta, PipelinesTemplateInstallerSet, &manifest, filterAndTransformCommon(), nil); err != nil {
+		if err := oe.installerSetClient.CustomSet(ctx, ta, PipelinesTemplateInstallerSet, &manifest, filterAndTransformCommon(), nil); err != nil {
 			return err
 		}
 	} else {
-		if err := r.installerSetClient.CleanupCustomSet(ctx, PipelinesTemplateInstallerSet); err != nil {
+		if err := oe.installerSetClient.CleanupCustomSet(ctx, PipelinesTemplateInstallerSet); err != nil {
 			return err
 		}
 	}
@@ -56,44 +56,3 @@ func TestUpdateConsoleCLIDownload(t *testing.T) {
 		t.Errorf("failed to update consoleclidownload %s", diff.PrintWantGot(d))
 	}
 }
-
-func TestSetVersionedNames(t *testing.T) {
-	tests := []struct {
-		name         string
-		inputPath    string
-		expectedPath string
-		errorMessage string
-	}{
-		{
-			name:         "test for versioned resolver task",
-			inputPath:    "test-versioned-resolvertask-name.yaml",
-			expectedPath: "test-versioned-resolver-name-expected.yaml",
-			errorMessage: "failed to update versioned resolver task name %s",
-		},
-		{
-			name:         "test for versioned resolver stepaction",
-			inputPath:    "test-versioned-resolverstepaction-name.yaml",
-			expectedPath: "test-versioned-resolver-stepaction-expected.yaml",
-			errorMessage: "failed to update versioned resolver stepaction name %s",
-		}}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			testData := path.Join("testdata", tt.inputPath)
-			manifest, err := mf.ManifestFrom(mf.Recursive(testData))
-			assert.NilError(t, err)
-
-			testData = path.Join("testdata", tt.expectedPath)
-			expectedManifest, err := mf.ManifestFrom(mf.Recursive(testData))
-			assert.NilError(t, err)
-
-			operatorVersion := "v1.7.0"
-			newManifest, err := manifest.Transform(setVersionedNames(operatorVersion))
-			assert.NilError(t, err)
-
-			if d := cmp.Diff(expectedManifest.Resources(), newManifest.Resources()); d != "" {
-				t.Errorf(tt.errorMessage, diff.PrintWantGot(d))
-			}
-		})
-	}
-}
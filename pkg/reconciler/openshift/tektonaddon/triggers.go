@@ -24,9 +24,9 @@ import (
 	"github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektoninstallerset/client"
 )
 
-func (r *Reconciler) EnsureTriggersResources(ctx context.Context, ta *v1alpha1.TektonAddon) error {
-	manifest := *r.triggersResourcesManifest
-	if err := r.installerSetClient.CustomSet(ctx, ta, TriggersResourcesInstallerSet, &manifest, filterAndTransformCommon(), nil); err != nil {
+func (oe openshiftExtension) EnsureTriggersResources(ctx context.Context, ta *v1alpha1.TektonAddon) error {
+	manifest := *oe.triggersResourcesManifest
+	if err := oe.installerSetClient.CustomSet(ctx, ta, TriggersResourcesInstallerSet, &manifest, filterAndTransformCommon(), nil); err != nil {
 		return err
 	}
 	return nil
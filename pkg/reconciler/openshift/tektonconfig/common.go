@@ -41,7 +41,9 @@ func createInstallerSet(ctx context.Context, oc versioned.Interface, tc *v1alpha
 	}
 
 	is := makeInstallerSet(tc, releaseVersion)
-	is.Spec.Manifests = pipelinescc.Resources()
+	if err := is.Spec.SetManifests(pipelinescc.Resources()); err != nil {
+		return err
+	}
 
 	createdIs, err := oc.OperatorV1alpha1().TektonInstallerSets().
 		Create(ctx, is, metav1.CreateOptions{})
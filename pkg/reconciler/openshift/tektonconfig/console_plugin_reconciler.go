@@ -118,7 +118,11 @@ func (cpr *consolePluginReconciler) reconcile(ctx context.Context, tektonConfigC
 
 	if !doCreateInstallerSet {
 		// compute hash from the deployed installerSet
-		deployedHash, err := cpr.getHash(deployedInstallerSet.Spec.Manifests)
+		deployedManifests, err := deployedInstallerSet.Spec.GetManifests()
+		if err != nil {
+			return err
+		}
+		deployedHash, err := cpr.getHash(deployedManifests)
 		if err != nil {
 			return err
 		}
@@ -184,9 +188,9 @@ func (cpr *consolePluginReconciler) createInstallerSet(ctx context.Context, mani
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			Manifests: manifest.Resources(),
-		},
+	}
+	if err := installerSet.Spec.SetManifests(manifest.Resources()); err != nil {
+		return err
 	}
 	// update operator version
 	installerSet.Labels[v1alpha1.ReleaseVersionKey] = cpr.operatorVersion
@@ -72,7 +72,7 @@ func createOPAC(ctx context.Context, clients op.OpenShiftPipelinesAsCodeInterfac
 			CommonSpec: v1alpha1.CommonSpec{
 				TargetNamespace: config.Spec.TargetNamespace,
 			},
-			Config: config.Spec.Config,
+			Config: config.Spec.Config.WithOverride(config.Spec.ComponentConfig.PipelinesAsCode),
 			PACSettings: v1alpha1.PACSettings{
 				Settings:                 config.Spec.Platforms.OpenShift.PipelinesAsCode.Settings,
 				AdditionalPACControllers: config.Spec.Platforms.OpenShift.PipelinesAsCode.PACSettings.AdditionalPACControllers,
@@ -105,8 +105,8 @@ func updateOPAC(ctx context.Context, opacCR *v1alpha1.OpenShiftPipelinesAsCode,
 		updated = true
 	}
 
-	if !reflect.DeepEqual(opacCR.Spec.Config, config.Spec.Config) {
-		opacCR.Spec.Config = config.Spec.Config
+	if pacConfig := config.Spec.Config.WithOverride(config.Spec.ComponentConfig.PipelinesAsCode); !reflect.DeepEqual(opacCR.Spec.Config, pacConfig) {
+		opacCR.Spec.Config = pacConfig
 		updated = true
 	}
 
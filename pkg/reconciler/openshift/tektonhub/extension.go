@@ -271,7 +271,10 @@ func checkIfInstallerSetExist(ctx context.Context, oc clientset.Interface, relVe
 func createInstallerSet(ctx context.Context, oc clientset.Interface, ta *v1alpha1.TektonHub,
 	manifest mf.Manifest, releaseVersion, component, installerSetPrefix string) error {
 
-	is := makeInstallerSet(ta, manifest, installerSetPrefix, releaseVersion, component)
+	is, err := makeInstallerSet(ta, manifest, installerSetPrefix, releaseVersion, component)
+	if err != nil {
+		return err
+	}
 
 	if _, err := oc.OperatorV1alpha1().TektonInstallerSets().
 		Create(ctx, is, metav1.CreateOptions{}); err != nil {
@@ -281,7 +284,7 @@ func createInstallerSet(ctx context.Context, oc clientset.Interface, ta *v1alpha
 	return nil
 }
 
-func makeInstallerSet(ta *v1alpha1.TektonHub, manifest mf.Manifest, prefix, releaseVersion, component string) *v1alpha1.TektonInstallerSet {
+func makeInstallerSet(ta *v1alpha1.TektonHub, manifest mf.Manifest, prefix, releaseVersion, component string) (*v1alpha1.TektonInstallerSet, error) {
 	ownerRef := *metav1.NewControllerRef(ta, ta.GetGroupVersionKind())
 	labels := map[string]string{
 		v1alpha1.CreatedByKey:      CreatedByValue,
@@ -290,7 +293,7 @@ func makeInstallerSet(ta *v1alpha1.TektonHub, manifest mf.Manifest, prefix, rele
 	}
 	namePrefix := fmt.Sprintf("%s-", prefix)
 
-	return &v1alpha1.TektonInstallerSet{
+	is := &v1alpha1.TektonInstallerSet{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: namePrefix,
 			Labels:       labels,
@@ -299,10 +302,11 @@ func makeInstallerSet(ta *v1alpha1.TektonHub, manifest mf.Manifest, prefix, rele
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			Manifests: manifest.Resources(),
-		},
 	}
+	if err := is.Spec.SetManifests(manifest.Resources()); err != nil {
+		return nil, err
+	}
+	return is, nil
 }
 
 func applyHubConsoleLinkManifest(manifest *mf.Manifest) error {
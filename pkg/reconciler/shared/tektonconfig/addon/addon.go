@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package extension
+package addon
 
 import (
 	"context"
@@ -73,9 +73,10 @@ func createAddon(ctx context.Context, clients op.TektonAddonInterface, config *v
 				TargetNamespace: config.Spec.TargetNamespace,
 			},
 			Addon: v1alpha1.Addon{
-				Params: config.Spec.Addon.Params,
+				Params:   config.Spec.Addon.Params,
+				Catalogs: config.Spec.Addon.Catalogs,
 			},
-			Config: config.Spec.Config,
+			Config: config.Spec.Config.WithOverride(config.Spec.ComponentConfig.Addon),
 		},
 	}
 	if _, err := clients.Create(ctx, taCR, metav1.CreateOptions{}); err != nil {
@@ -109,8 +110,8 @@ func updateAddon(ctx context.Context, taCR *v1alpha1.TektonAddon, config *v1alph
 		updated = true
 	}
 
-	if !reflect.DeepEqual(taCR.Spec.Config, config.Spec.Config) {
-		taCR.Spec.Config = config.Spec.Config
+	if addonConfig := config.Spec.Config.WithOverride(config.Spec.ComponentConfig.Addon); !reflect.DeepEqual(taCR.Spec.Config, addonConfig) {
+		taCR.Spec.Config = addonConfig
 		updated = true
 	}
 
@@ -163,7 +163,7 @@ func GetTektonChainCR(config *v1alpha1.TektonConfig, operatorVersion string) *v1
 			CommonSpec: v1alpha1.CommonSpec{
 				TargetNamespace: config.Spec.TargetNamespace,
 			},
-			Config: config.Spec.Config,
+			Config: config.Spec.Config.WithOverride(config.Spec.ComponentConfig.Chain),
 			Chain:  config.Spec.Chain,
 		},
 	}
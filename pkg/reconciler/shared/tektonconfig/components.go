@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/chain"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pipeline"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pruner"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/result"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/trigger"
+	"knative.dev/pkg/logging"
+)
+
+// prunerComponentName is the name of the job based pruner in the component status
+const prunerComponentName = "Pruner"
+
+var errPrunersConflict = errors.New("invalid pruner configuration, both pruners, tektonpruner(event based) and pruner(job based) cannot be enabled simultaneously, please disable one of them")
+
+// component is a node of the dependency graph of the components installed by TektonConfig
+type component struct {
+	name string
+	// the components which must be ready before this one is ensured
+	dependsOn []string
+	// a disabled component is removed, which does not wait for its dependencies
+	enabled bool
+	ensure  func(ctx context.Context) error
+	remove  func(ctx context.Context) error
+}
+
+// components returns the dependency graph of the components, the components depending on
+// TektonPipeline are gated by it, the others are reconciled independently. A component is
+// enabled if the profile installs it and it is not disabled in the TektonConfig
+func (r *Reconciler) components(tc *v1alpha1.TektonConfig, profile v1alpha1.TektonProfileSpec) []component {
+	operatorClient := r.operatorClientSet.OperatorV1alpha1()
+	dependsOnPipeline := []string{v1alpha1.KindTektonPipeline}
+	eventPrunerEnabled := profile.Enables(v1alpha1.ComponentTektonPruner) && !tc.Spec.TektonPruner.IsDisabled()
+	// neither pruner is applied while both are enabled
+	prunersConflict := eventPrunerEnabled && !tc.Spec.Pruner.Disabled
+
+	return []component{
+		{
+			name:    v1alpha1.KindTektonPipeline,
+			enabled: true,
+			ensure: func(ctx context.Context) error {
+				_, err := pipeline.EnsureTektonPipelineExists(ctx, operatorClient.TektonPipelines(), pipeline.GetTektonPipelineCR(tc, r.operatorVersion))
+				return err
+			},
+		},
+		{
+			// Start Event based Pruner only if old Job based Pruner is Disabled.
+			name:      v1alpha1.KindTektonPruner,
+			dependsOn: dependsOnPipeline,
+			enabled:   eventPrunerEnabled,
+			ensure: func(ctx context.Context) error {
+				if prunersConflict {
+					return errPrunersConflict
+				}
+				_, err := pruner.EnsureTektonPrunerExists(ctx, operatorClient.TektonPruners(), pruner.GetTektonPrunerCR(tc, r.operatorVersion))
+				return err
+			},
+			remove: func(ctx context.Context) error {
+				return pruner.EnsureTektonPrunerCRNotExists(ctx, operatorClient.TektonPruners())
+			},
+		},
+		{
+			name:      v1alpha1.KindTektonTrigger,
+			dependsOn: dependsOnPipeline,
+			enabled:   profile.Enables(v1alpha1.ComponentTrigger) && !tc.Spec.Trigger.Disabled,
+			ensure: func(ctx context.Context) error {
+				_, err := trigger.EnsureTektonTriggerExists(ctx, operatorClient.TektonTriggers(), trigger.GetTektonTriggerCR(tc, r.operatorVersion))
+				return err
+			},
+			remove: func(ctx context.Context) error {
+				return trigger.EnsureTektonTriggerCRNotExists(ctx, operatorClient.TektonTriggers())
+			},
+		},
+		{
+			name:      v1alpha1.KindTektonChain,
+			dependsOn: dependsOnPipeline,
+			enabled:   profile.Enables(v1alpha1.ComponentChain) && !tc.Spec.Chain.Disabled,
+			ensure: func(ctx context.Context) error {
+				_, err := chain.EnsureTektonChainExists(ctx, operatorClient.TektonChains(), chain.GetTektonChainCR(tc, r.operatorVersion))
+				return err
+			},
+			remove: func(ctx context.Context) error {
+				return chain.EnsureTektonChainCRNotExists(ctx, operatorClient.TektonChains())
+			},
+		},
+		{
+			name:      v1alpha1.KindTektonResult,
+			dependsOn: dependsOnPipeline,
+			enabled:   profile.Enables(v1alpha1.ComponentResult) && !tc.Spec.Result.Disabled,
+			ensure: func(ctx context.Context) error {
+				_, err := result.EnsureTektonResultExists(ctx, operatorClient.TektonResults(), result.GetTektonResultCR(tc, r.operatorVersion))
+				return err
+			},
+			remove: func(ctx context.Context) error {
+				return result.EnsureTektonResultCRNotExists(ctx, operatorClient.TektonResults())
+			},
+		},
+		{
+			name:    prunerComponentName,
+			enabled: !tc.Spec.Pruner.Disabled,
+			ensure: func(ctx context.Context) error {
+				if prunersConflict {
+					return errPrunersConflict
+				}
+				return r.reconcilePrunerInstallerSet(ctx, tc)
+			},
+		},
+	}
+}
+
+// reconcileComponents reconciles the components in waves, the components of a wave depend
+// only on the components of the previous waves and are reconciled concurrently
+func reconcileComponents(ctx context.Context, components []component) []v1alpha1.ComponentStatus {
+	logger := logging.FromContext(ctx)
+
+	statuses := make([]v1alpha1.ComponentStatus, len(components))
+	done := map[string]*v1alpha1.ComponentStatus{}
+	pending := make([]int, 0, len(components))
+	for i, c := range components {
+		statuses[i] = v1alpha1.ComponentStatus{Name: c.name, DependsOn: c.dependsOn}
+		pending = append(pending, i)
+	}
+
+	for len(pending) > 0 {
+		var wave, next []int
+		for _, i := range pending {
+			if !components[i].enabled || allDone(done, components[i].dependsOn) {
+				wave = append(wave, i)
+			} else {
+				next = append(next, i)
+			}
+		}
+		if len(wave) == 0 {
+			// the graph is static, a cycle or an unknown dependency is a programming error
+			for _, i := range next {
+				statuses[i].State = v1alpha1.ComponentStateNotReady
+				statuses[i].Message = fmt.Sprintf("unresolved dependencies: %s", strings.Join(components[i].dependsOn, ", "))
+			}
+			break
+		}
+
+		var wg sync.WaitGroup
+		for _, i := range wave {
+			wg.Add(1)
+			go func(c component, status *v1alpha1.ComponentStatus) {
+				defer wg.Done()
+				reconcileComponent(ctx, c, done, status)
+			}(components[i], &statuses[i])
+		}
+		wg.Wait()
+
+		for _, i := range wave {
+			done[statuses[i].Name] = &statuses[i]
+			logger.Debugw("Component reconciled", "component", statuses[i].Name, "state", statuses[i].State, "message", statuses[i].Message)
+		}
+		pending = next
+	}
+	return statuses
+}
+
+// reconcileComponent ensures or removes a component once its dependencies are reconciled,
+// done is only read as it is written between the waves
+func reconcileComponent(ctx context.Context, c component, done map[string]*v1alpha1.ComponentStatus, status *v1alpha1.ComponentStatus) {
+	if !c.enabled {
+		status.State = v1alpha1.ComponentStateDisabled
+		if c.remove == nil {
+			return
+		}
+		if err := c.remove(ctx); err != nil {
+			status.State = v1alpha1.ComponentStateNotReady
+			status.Message = err.Error()
+		}
+		return
+	}
+
+	var waiting []string
+	for _, dependency := range c.dependsOn {
+		if done[dependency].State != v1alpha1.ComponentStateReady {
+			waiting = append(waiting, dependency)
+		}
+	}
+	if len(waiting) > 0 {
+		status.State = v1alpha1.ComponentStateWaitingForDependencies
+		status.Message = fmt.Sprintf("waiting for %s to be ready", strings.Join(waiting, ", "))
+		return
+	}
+
+	if err := c.ensure(ctx); err != nil {
+		status.State = v1alpha1.ComponentStateNotReady
+		status.Message = err.Error()
+		if err == v1alpha1.RECONCILE_AGAIN_ERR {
+			status.Message = "component is being installed"
+		}
+		return
+	}
+	status.State = v1alpha1.ComponentStateReady
+}
+
+func allDone(done map[string]*v1alpha1.ComponentStatus, names []string) bool {
+	for _, name := range names {
+		if _, ok := done[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// componentsNotReady returns the reasons of the components which are not ready, in the
+// format of the ComponentsReady condition message
+func componentsNotReady(statuses []v1alpha1.ComponentStatus) string {
+	var reasons []string
+	for _, status := range statuses {
+		if status.State == v1alpha1.ComponentStateNotReady || status.State == v1alpha1.ComponentStateWaitingForDependencies {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", status.Name, status.Message))
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorFake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	"gotest.tools/v3/assert"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func succeed(context.Context) error { return nil }
+
+func TestReconcileComponents(t *testing.T) {
+	// the result component only succeeds once the chain component ran, which
+	// requires the components of a wave to be reconciled concurrently
+	chainDone := make(chan struct{})
+	components := []component{
+		{name: "Pipeline", enabled: true, ensure: succeed},
+		{name: "Result", dependsOn: []string{"Pipeline"}, enabled: true, ensure: func(context.Context) error {
+			select {
+			case <-chainDone:
+				return nil
+			case <-time.After(5 * time.Second):
+				return errors.New("database not reachable")
+			}
+		}},
+		{name: "Chain", dependsOn: []string{"Pipeline"}, enabled: true, ensure: func(context.Context) error {
+			close(chainDone)
+			return nil
+		}},
+		{name: "Dashboard", enabled: false},
+	}
+
+	statuses := reconcileComponents(context.TODO(), components)
+
+	assert.DeepEqual(t, statuses, []v1alpha1.ComponentStatus{
+		{Name: "Pipeline", State: v1alpha1.ComponentStateReady},
+		{Name: "Result", DependsOn: []string{"Pipeline"}, State: v1alpha1.ComponentStateReady},
+		{Name: "Chain", DependsOn: []string{"Pipeline"}, State: v1alpha1.ComponentStateReady},
+		{Name: "Dashboard", State: v1alpha1.ComponentStateDisabled},
+	})
+	assert.Equal(t, componentsNotReady(statuses), "")
+}
+
+func TestReconcileComponents_NotReady(t *testing.T) {
+	removed := false
+	components := []component{
+		{name: "Pipeline", enabled: true, ensure: func(context.Context) error { return v1alpha1.RECONCILE_AGAIN_ERR }},
+		{name: "Chain", dependsOn: []string{"Pipeline"}, enabled: true, ensure: func(context.Context) error {
+			t.Error("a component is not ensured before its dependencies are ready")
+			return nil
+		}},
+		// removing a component does not wait for its dependencies
+		{name: "Trigger", dependsOn: []string{"Pipeline"}, enabled: false, remove: func(context.Context) error {
+			removed = true
+			return nil
+		}},
+		{name: "Pruner", enabled: true, ensure: func(context.Context) error { return errors.New("invalid schedule") }},
+		{name: "Cycle", dependsOn: []string{"Unknown"}, enabled: true, ensure: succeed},
+	}
+
+	statuses := reconcileComponents(context.TODO(), components)
+
+	assert.Assert(t, removed)
+	assert.DeepEqual(t, statuses, []v1alpha1.ComponentStatus{
+		{Name: "Pipeline", State: v1alpha1.ComponentStateNotReady, Message: "component is being installed"},
+		{Name: "Chain", DependsOn: []string{"Pipeline"}, State: v1alpha1.ComponentStateWaitingForDependencies, Message: "waiting for Pipeline to be ready"},
+		{Name: "Trigger", DependsOn: []string{"Pipeline"}, State: v1alpha1.ComponentStateDisabled},
+		{Name: "Pruner", State: v1alpha1.ComponentStateNotReady, Message: "invalid schedule"},
+		{Name: "Cycle", DependsOn: []string{"Unknown"}, State: v1alpha1.ComponentStateNotReady, Message: "unresolved dependencies: Unknown"},
+	})
+	assert.Equal(t, componentsNotReady(statuses),
+		"Pipeline: component is being installed; Chain: waiting for Pipeline to be ready; Pruner: invalid schedule; Cycle: unresolved dependencies: Unknown")
+}
+
+func TestComponents(t *testing.T) {
+	ctx := context.TODO()
+	// the results are not installed by the profile
+	operatorClient := operatorFake.NewSimpleClientset(&v1alpha1.TektonProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "secure"},
+		Spec: v1alpha1.TektonProfileSpec{Components: []string{
+			v1alpha1.ComponentPipeline, v1alpha1.ComponentChain, v1alpha1.ComponentTektonPruner,
+		}},
+	})
+	r := &Reconciler{operatorClientSet: operatorClient, operatorVersion: "v0.78.0"}
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			Profile:      "secure",
+			CommonSpec:   v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			Pruner:       v1alpha1.Prune{Disabled: true},
+			TektonPruner: v1alpha1.Pruner{Disabled: ptr.Bool(false)},
+		},
+	}
+	profile, err := common.TektonProfile(ctx, operatorClient, tc.Spec.Profile)
+	assert.NilError(t, err)
+
+	// the pipeline is created first, the components depending on it wait
+	statuses := reconcileComponents(ctx, r.components(tc, profile))
+	states := map[string]string{}
+	for _, status := range statuses {
+		states[status.Name] = status.State
+	}
+	assert.DeepEqual(t, states, map[string]string{
+		v1alpha1.KindTektonPipeline: v1alpha1.ComponentStateNotReady,
+		v1alpha1.KindTektonPruner:   v1alpha1.ComponentStateWaitingForDependencies,
+		v1alpha1.KindTektonTrigger:  v1alpha1.ComponentStateDisabled,
+		v1alpha1.KindTektonChain:    v1alpha1.ComponentStateWaitingForDependencies,
+		v1alpha1.KindTektonResult:   v1alpha1.ComponentStateDisabled,
+		prunerComponentName:         v1alpha1.ComponentStateDisabled,
+	})
+	_, err = operatorClient.OperatorV1alpha1().TektonChains().Get(ctx, v1alpha1.ChainResourceName, metav1.GetOptions{})
+	assert.Assert(t, apierrs.IsNotFound(err))
+
+	// once the pipeline is ready, its dependents are created
+	tp, err := operatorClient.OperatorV1alpha1().TektonPipelines().Get(ctx, v1alpha1.PipelineResourceName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	tp.Status.InitializeConditions()
+	tp.Status.MarkPreReconcilerComplete()
+	tp.Status.MarkInstallerSetAvailable()
+	tp.Status.MarkInstallerSetReady()
+	tp.Status.MarkPostReconcilerComplete()
+	assert.Assert(t, tp.Status.GetCondition(apis.ConditionReady).IsTrue())
+	_, err = operatorClient.OperatorV1alpha1().TektonPipelines().UpdateStatus(ctx, tp, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+
+	statuses = reconcileComponents(ctx, r.components(tc, profile))
+	assert.Equal(t, statuses[0].State, v1alpha1.ComponentStateReady)
+	_, err = operatorClient.OperatorV1alpha1().TektonChains().Get(ctx, v1alpha1.ChainResourceName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	_, err = operatorClient.OperatorV1alpha1().TektonPruners().Get(ctx, v1alpha1.TektonPrunerResourceName, metav1.GetOptions{})
+	assert.NilError(t, err)
+}
+
+func TestComponents_PrunersConflict(t *testing.T) {
+	ctx := context.TODO()
+	operatorClient := operatorFake.NewSimpleClientset(&v1alpha1.TektonProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "pruned"},
+		Spec: v1alpha1.TektonProfileSpec{Components: []string{
+			v1alpha1.ComponentPipeline, v1alpha1.ComponentTektonPruner,
+		}},
+	})
+	r := &Reconciler{operatorClientSet: operatorClient, operatorVersion: "v0.78.0"}
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec: v1alpha1.TektonConfigSpec{
+			Profile:      "pruned",
+			CommonSpec:   v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"},
+			TektonPruner: v1alpha1.Pruner{Disabled: ptr.Bool(false)},
+		},
+	}
+	profile, err := common.TektonProfile(ctx, operatorClient, tc.Spec.Profile)
+	assert.NilError(t, err)
+
+	// the job based pruner is not applied while the event based pruner is enabled too
+	statuses := reconcileComponents(ctx, r.components(tc, profile))
+	for _, status := range statuses {
+		if status.Name == prunerComponentName {
+			assert.Equal(t, status.State, v1alpha1.ComponentStateNotReady)
+			assert.Equal(t, status.Message, errPrunersConflict.Error())
+		}
+	}
+	installerSets, err := operatorClient.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(installerSets.Items), 0)
+}
@@ -30,6 +30,7 @@ import (
 	tektonConfiginformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonconfig"
 	tektonInstallerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektoninstallerset"
 	tektonPipelineinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonpipeline"
+	tektonProfileinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonprofile"
 	tektonResultinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektonresult"
 	tektonTriggerinformer "github.com/tektoncd/operator/pkg/client/injection/informers/operator/v1alpha1/tektontrigger"
 	tektonConfigreconciler "github.com/tektoncd/operator/pkg/client/injection/reconciler/operator/v1alpha1/tektonconfig"
@@ -120,6 +121,13 @@ func NewExtensibleController(generator common.ExtensionGenerator) injection.Cont
 			logger.Panicf("Couldn't register TektonInstallerSet informer event handler: %w", err)
 		}
 
+		// a change of a TektonProfile changes the components installed by the TektonConfig
+		if _, err := tektonProfileinformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(func(interface{}) {
+			impl.EnqueueKey(types.NamespacedName{Name: v1alpha1.ConfigResourceName})
+		})); err != nil {
+			logger.Panicf("Couldn't register TektonProfile informer event handler: %w", err)
+		}
+
 		if _, err := namespaceinformer.Get(ctx).Informer().AddEventHandler(controller.HandleAll(enqueueCustomName(impl, v1alpha1.ConfigResourceName))); err != nil {
 			logger.Panicf("Couldn't register Namespace informer event handler: %w", err)
 		}
@@ -166,10 +166,10 @@ func (r *Reconciler) createPrunerInstallerSet(ctx context.Context, tc *v1alpha1.
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			// include resources from manifest
-			Manifests: manifest.Resources(),
-		},
+	}
+	// include resources from manifest
+	if err := prunerInstallerSet.Spec.SetManifests(manifest.Resources()); err != nil {
+		return err
 	}
 
 	// creates installerSet in the cluster
@@ -77,7 +77,7 @@ func GetTektonPipelineCR(config *v1alpha1.TektonConfig, operatorVersion string)
 				TargetNamespace: config.Spec.TargetNamespace,
 			},
 			Pipeline: config.Spec.Pipeline,
-			Config:   config.Spec.Config,
+			Config:   config.Spec.Config.WithOverride(config.Spec.ComponentConfig.Pipeline),
 		},
 	}
 }
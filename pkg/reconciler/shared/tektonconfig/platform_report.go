@@ -0,0 +1,439 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/shared/hash"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// PlatformReportConfigMapName is the ConfigMap holding the bill of materials of the installed components
+	PlatformReportConfigMapName = "tekton-platform-report"
+	// PlatformReportKey is the key of the CycloneDX document in the ConfigMap
+	PlatformReportKey = "bom.cdx.json"
+
+	platformReportFormat   = "CycloneDX 1.5"
+	platformReportProperty = "operator.tekton.dev/"
+)
+
+// cdxBOM is the subset of the CycloneDX 1.5 bill of materials written by the operator
+type cdxBOM struct {
+	BOMFormat    string         `json:"bomFormat"`
+	SpecVersion  string         `json:"specVersion"`
+	SerialNumber string         `json:"serialNumber"`
+	Version      int            `json:"version"`
+	Metadata     cdxMetadata    `json:"metadata"`
+	Components   []cdxComponent `json:"components"`
+}
+
+type cdxMetadata struct {
+	Timestamp string       `json:"timestamp"`
+	Component cdxComponent `json:"component"`
+}
+
+type cdxComponent struct {
+	Type       string         `json:"type"`
+	BOMRef     string         `json:"bom-ref"`
+	Name       string         `json:"name"`
+	Version    string         `json:"version,omitempty"`
+	PURL       string         `json:"purl,omitempty"`
+	Hashes     []cdxHash      `json:"hashes,omitempty"`
+	Properties []cdxProperty  `json:"properties,omitempty"`
+	Components []cdxComponent `json:"components,omitempty"`
+}
+
+type cdxHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cdxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// reconcilePlatformReport writes the CycloneDX bill of materials of the components installed
+// through installer sets in the target namespace, the ConfigMap is only updated when the
+// reported components change
+func (r *Reconciler) reconcilePlatformReport(ctx context.Context, tc *v1alpha1.TektonConfig) error {
+	components, err := r.platformComponents(ctx)
+	if err != nil {
+		return err
+	}
+	componentsHash, err := hash.Compute(components)
+	if err != nil {
+		return err
+	}
+
+	configMaps := r.kubeClientSet.CoreV1().ConfigMaps(tc.Spec.GetTargetNamespace())
+	existing, err := configMaps.Get(ctx, PlatformReportConfigMapName, metav1.GetOptions{})
+	found := err == nil
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if found && existing.Annotations[v1alpha1.LastAppliedHashKey] == componentsHash {
+		if tc.Status.PlatformReport == nil || tc.Status.PlatformReport.Hash != componentsHash {
+			existingBOM := cdxBOM{}
+			updated := existing.CreationTimestamp
+			if err := json.Unmarshal([]byte(existing.Data[PlatformReportKey]), &existingBOM); err == nil {
+				if t, err := time.Parse(time.RFC3339, existingBOM.Metadata.Timestamp); err == nil {
+					updated = metav1.NewTime(t)
+				}
+			}
+			tc.Status.PlatformReport = platformReportStatus(componentsHash, len(components), updated)
+		}
+		return nil
+	}
+
+	now := metav1.NewTime(time.Now().UTC())
+	bom := cdxBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: serialNumber(componentsHash),
+		Version:      1,
+		Metadata: cdxMetadata{
+			Timestamp: now.Format(time.RFC3339),
+			Component: cdxComponent{
+				Type:    "platform",
+				BOMRef:  "tekton-operator",
+				Name:    "tekton-operator",
+				Version: r.operatorVersion,
+			},
+		},
+		Components: components,
+	}
+	data, err := json.MarshalIndent(bom, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            PlatformReportConfigMapName,
+			Namespace:       tc.Spec.GetTargetNamespace(),
+			Labels:          map[string]string{v1alpha1.CreatedByKey: v1alpha1.KindTektonConfig},
+			Annotations:     map[string]string{v1alpha1.LastAppliedHashKey: componentsHash},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(tc, tc.GroupVersionKind())},
+		},
+		Data: map[string]string{PlatformReportKey: string(data)},
+	}
+	if !found {
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	} else {
+		cm.ResourceVersion = existing.ResourceVersion
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return err
+	}
+	tc.Status.PlatformReport = platformReportStatus(componentsHash, len(components), now)
+	return nil
+}
+
+func platformReportStatus(componentsHash string, components int, updated metav1.Time) *v1alpha1.PlatformReportStatus {
+	return &v1alpha1.PlatformReportStatus{
+		ConfigMap:      PlatformReportConfigMapName,
+		Format:         platformReportFormat,
+		Hash:           componentsHash,
+		Components:     components,
+		LastUpdateTime: &updated,
+	}
+}
+
+// platformComponents returns a component per owner of installer sets, with the release version,
+// the configuration hash and the manifest hash of each installer set, and the images of the workloads
+func (r *Reconciler) platformComponents(ctx context.Context) ([]cdxComponent, error) {
+	installerSets, err := r.operatorClientSet.OperatorV1alpha1().TektonInstallerSets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sets := installerSets.Items
+	sort.Slice(sets, func(i, j int) bool { return sets[i].Name < sets[j].Name })
+
+	// the images of the workloads are reported with the digest the pods are running
+	namespaces := map[string]bool{}
+	manifests := make([]mf.Slice, len(sets))
+	for i, set := range sets {
+		if manifests[i], err = set.Spec.GetManifests(); err != nil {
+			return nil, err
+		}
+		for _, resource := range manifests[i] {
+			if resource.GetNamespace() != "" && len(workloadContainers(resource)) > 0 {
+				namespaces[resource.GetNamespace()] = true
+			}
+		}
+	}
+	digests, err := r.imageDigests(ctx, namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	components := []cdxComponent{}
+	index := map[string]int{}
+	for s, set := range sets {
+		kind, name := set.Labels[v1alpha1.CreatedByKey], ""
+		if owner := metav1.GetControllerOf(&set); owner != nil {
+			kind, name = owner.Kind, owner.Name
+		}
+		if kind == "" {
+			continue
+		}
+		ref := strings.TrimSuffix(kind+"/"+name, "/")
+
+		i, ok := index[ref]
+		if !ok {
+			version, err := r.componentVersion(ctx, kind, name)
+			if err != nil {
+				return nil, err
+			}
+			components = append(components, cdxComponent{
+				Type:    "application",
+				BOMRef:  ref,
+				Name:    kind,
+				Version: version,
+			})
+			i = len(components) - 1
+			index[ref] = i
+		}
+		component := &components[i]
+
+		if configHash := set.Annotations[v1alpha1.LastAppliedHashKey]; configHash != "" && !hasProperty(component.Properties, "configuration-hash") {
+			component.Properties = append(component.Properties, property("configuration-hash", configHash))
+		}
+		if set.Status.AppliedSpecHash != "" {
+			setType := set.Labels[v1alpha1.InstallerSetType]
+			if setType == "" {
+				setType = set.Name
+			}
+			component.Properties = append(component.Properties, property("manifest-hash/"+setType, set.Status.AppliedSpecHash))
+		}
+
+		for _, resource := range manifests[s] {
+			workload := fmt.Sprintf("%s/%s/%s", resource.GetKind(), resource.GetNamespace(), resource.GetName())
+			for _, container := range workloadContainers(resource) {
+				component.Components = append(component.Components,
+					imageComponent(ref+"/"+workload+"/"+container[0], workload, container[0], container[1], digests[container[1]]))
+			}
+		}
+	}
+	return components, nil
+}
+
+// componentVersion returns the installed release version of the component
+func (r *Reconciler) componentVersion(ctx context.Context, kind, name string) (string, error) {
+	client := r.operatorClientSet.OperatorV1alpha1()
+	var status v1alpha1.TektonComponentStatus
+	var err error
+	switch kind {
+	case v1alpha1.KindTektonConfig:
+		return r.operatorVersion, nil
+	case v1alpha1.KindTektonPipeline:
+		var comp *v1alpha1.TektonPipeline
+		if comp, err = client.TektonPipelines().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	case v1alpha1.KindTektonTrigger:
+		var comp *v1alpha1.TektonTrigger
+		if comp, err = client.TektonTriggers().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	case v1alpha1.KindTektonChain:
+		var comp *v1alpha1.TektonChain
+		if comp, err = client.TektonChains().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	case v1alpha1.KindTektonResult:
+		var comp *v1alpha1.TektonResult
+		if comp, err = client.TektonResults().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	case v1alpha1.KindTektonDashboard:
+		var comp *v1alpha1.TektonDashboard
+		if comp, err = client.TektonDashboards().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	case v1alpha1.KindTektonHub:
+		var comp *v1alpha1.TektonHub
+		if comp, err = client.TektonHubs().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	case v1alpha1.KindTektonAddon:
+		var comp *v1alpha1.TektonAddon
+		if comp, err = client.TektonAddons().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	case v1alpha1.KindTektonPruner:
+		var comp *v1alpha1.TektonPruner
+		if comp, err = client.TektonPruners().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	case v1alpha1.KindManualApprovalGate:
+		var comp *v1alpha1.ManualApprovalGate
+		if comp, err = client.ManualApprovalGates().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	case v1alpha1.KindOpenShiftPipelinesAsCode:
+		var comp *v1alpha1.OpenShiftPipelinesAsCode
+		if comp, err = client.OpenShiftPipelinesAsCodes().Get(ctx, name, metav1.GetOptions{}); err == nil {
+			status = &comp.Status
+		}
+	default:
+		return "", nil
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return status.GetVersion(), nil
+}
+
+// imageDigests returns the digests of the images run by the pods of the namespaces
+func (r *Reconciler) imageDigests(ctx context.Context, namespaces map[string]bool) (map[string]string, error) {
+	digests := map[string]string{}
+	for namespace := range namespaces {
+		pods, err := r.kubeClientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods.Items {
+			images := map[string]string{}
+			for _, c := range pod.Spec.InitContainers {
+				images[c.Name] = c.Image
+			}
+			for _, c := range pod.Spec.Containers {
+				images[c.Name] = c.Image
+			}
+			for _, s := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+				// the image id is the repository digest, e.g. docker-pullable://registry/image@sha256:...
+				if i := strings.LastIndex(s.ImageID, "@"); i >= 0 && images[s.Name] != "" {
+					digests[images[s.Name]] = s.ImageID[i+1:]
+				}
+			}
+		}
+	}
+	return digests, nil
+}
+
+// workloadContainers returns the name and image of the containers of a workload
+func workloadContainers(resource unstructured.Unstructured) [][2]string {
+	var podSpec []string
+	switch resource.GetKind() {
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job":
+		podSpec = []string{"spec", "template", "spec"}
+	case "CronJob":
+		podSpec = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	default:
+		return nil
+	}
+
+	containers := [][2]string{}
+	for _, field := range []string{"initContainers", "containers"} {
+		list, _, _ := unstructured.NestedSlice(resource.Object, append(podSpec, field)...)
+		for _, c := range list {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(container, "name")
+			image, _, _ := unstructured.NestedString(container, "image")
+			if image != "" {
+				containers = append(containers, [2]string{name, image})
+			}
+		}
+	}
+	return containers
+}
+
+// imageComponent returns the container component of an image, the digest of the
+// reference is used when the image is pinned
+func imageComponent(ref, workload, container, image, runningDigest string) cdxComponent {
+	repository, tag, digest := splitImage(image)
+	if digest == "" {
+		digest = runningDigest
+	}
+
+	component := cdxComponent{
+		Type:    "container",
+		BOMRef:  ref,
+		Name:    repository,
+		Version: tag,
+		Properties: []cdxProperty{
+			property("image", image),
+			property("workload", workload),
+			property("container", container),
+		},
+	}
+	if digest != "" {
+		component.Version = digest
+		purl := fmt.Sprintf("pkg:oci/%s@%s?repository_url=%s",
+			repository[strings.LastIndex(repository, "/")+1:], strings.Replace(digest, ":", "%3A", 1), repository)
+		if tag != "" {
+			purl += "&tag=" + tag
+		}
+		component.PURL = purl
+		if alg, content, ok := strings.Cut(digest, ":"); ok && alg == "sha256" {
+			component.Hashes = []cdxHash{{Alg: "SHA-256", Content: content}}
+		}
+	}
+	return component
+}
+
+// splitImage splits an image reference in repository, tag and digest
+func splitImage(image string) (repository, tag, digest string) {
+	repository = image
+	if i := strings.Index(image, "@"); i >= 0 {
+		repository, digest = image[:i], image[i+1:]
+	}
+	// the tag follows the last colon after the last slash, a colon before is the registry port
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:i], repository[i+1:]
+	}
+	return repository, tag, digest
+}
+
+// serialNumber returns an uuid derived from the hash, so the same components give the same serial number
+func serialNumber(h string) string {
+	return fmt.Sprintf("urn:uuid:%s-%s-5%s-a%s-%s", h[0:8], h[8:12], h[13:16], h[17:20], h[20:32])
+}
+
+func property(name, value string) cdxProperty {
+	return cdxProperty{Name: platformReportProperty + name, Value: value}
+}
+
+func hasProperty(properties []cdxProperty, name string) bool {
+	for _, p := range properties {
+		if p.Name == platformReportProperty+name {
+			return true
+		}
+	}
+	return false
+}
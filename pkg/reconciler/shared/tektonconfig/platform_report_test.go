@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mf "github.com/manifestival/manifestival"
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorFake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func testDeployment(name, namespace string, images ...string) unstructured.Unstructured {
+	containers := []interface{}{}
+	for i, image := range images {
+		containers = append(containers, map[string]interface{}{"name": name + "-" + string(rune('a'+i)), "image": image})
+	}
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{"containers": containers},
+			},
+		},
+	}}
+}
+
+func testInstallerSet(name, setType string, owner metav1.Object, kind string, manifests ...unstructured.Unstructured) *v1alpha1.TektonInstallerSet {
+	return &v1alpha1.TektonInstallerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Labels:          map[string]string{v1alpha1.CreatedByKey: kind, v1alpha1.InstallerSetType: setType},
+			Annotations:     map[string]string{v1alpha1.LastAppliedHashKey: "config-" + kind},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(owner, v1alpha1.SchemeGroupVersion.WithKind(kind))},
+		},
+		Spec:   v1alpha1.TektonInstallerSetSpec{Manifests: mf.Slice(manifests)},
+		Status: v1alpha1.TektonInstallerSetStatus{AppliedSpecHash: "manifest-" + name},
+	}
+}
+
+func TestReconcilePlatformReport(t *testing.T) {
+	ctx := context.TODO()
+	tp := &v1alpha1.TektonPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.PipelineResourceName},
+		Status:     v1alpha1.TektonPipelineStatus{Version: "v1.0.0"},
+	}
+	tt := &v1alpha1.TektonTrigger{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.TriggerResourceName},
+		Status:     v1alpha1.TektonTriggerStatus{Version: "v0.30.0"},
+	}
+	operatorClient := operatorFake.NewSimpleClientset(tp, tt,
+		testInstallerSet("pipeline-main-deployment-abc", "main-deployment", tp, v1alpha1.KindTektonPipeline,
+			testDeployment("tekton-pipelines-controller", "tekton-pipelines", "ghcr.io/tektoncd/pipeline/controller:v1.0.0")),
+		testInstallerSet("pipeline-main-static-abc", "main-static", tp, v1alpha1.KindTektonPipeline),
+		testInstallerSet("trigger-main-deployment-abc", "main-deployment", tt, v1alpha1.KindTektonTrigger,
+			testDeployment("tekton-triggers-webhook", "tekton-pipelines", "ghcr.io/tektoncd/triggers/webhook@"+testDigest)),
+	)
+	kubeClient := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "tekton-pipelines-controller-1", Namespace: "tekton-pipelines"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "tekton-pipelines-controller-a", Image: "ghcr.io/tektoncd/pipeline/controller:v1.0.0"},
+		}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "tekton-pipelines-controller-a", ImageID: "docker-pullable://ghcr.io/tektoncd/pipeline/controller@" + testDigest},
+		}},
+	})
+	r := &Reconciler{kubeClientSet: kubeClient, operatorClientSet: operatorClient, operatorVersion: "v0.78.0"}
+	tc := &v1alpha1.TektonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName},
+		Spec:       v1alpha1.TektonConfigSpec{CommonSpec: v1alpha1.CommonSpec{TargetNamespace: "tekton-pipelines"}},
+	}
+
+	assert.NilError(t, r.reconcilePlatformReport(ctx, tc))
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, PlatformReportConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	bom := cdxBOM{}
+	assert.NilError(t, json.Unmarshal([]byte(cm.Data[PlatformReportKey]), &bom))
+	assert.Equal(t, bom.BOMFormat, "CycloneDX")
+	assert.Equal(t, bom.Metadata.Component.Version, "v0.78.0")
+	assert.Equal(t, len(bom.Components), 2)
+
+	pipeline := bom.Components[0]
+	assert.Equal(t, pipeline.Name, v1alpha1.KindTektonPipeline)
+	assert.Equal(t, pipeline.Version, "v1.0.0")
+	assert.DeepEqual(t, pipeline.Properties, []cdxProperty{
+		property("configuration-hash", "config-TektonPipeline"),
+		property("manifest-hash/main-deployment", "manifest-pipeline-main-deployment-abc"),
+		property("manifest-hash/main-static", "manifest-pipeline-main-static-abc"),
+	})
+	// the digest of a tagged image is the one of the running pod
+	assert.Equal(t, len(pipeline.Components), 1)
+	controller := pipeline.Components[0]
+	assert.Equal(t, controller.Type, "container")
+	assert.Equal(t, controller.Name, "ghcr.io/tektoncd/pipeline/controller")
+	assert.Equal(t, controller.Version, testDigest)
+	assert.Equal(t, controller.PURL, "pkg:oci/controller@sha256%3A0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef?repository_url=ghcr.io/tektoncd/pipeline/controller&tag=v1.0.0")
+	assert.DeepEqual(t, controller.Hashes, []cdxHash{{Alg: "SHA-256", Content: testDigest[len("sha256:"):]}})
+
+	trigger := bom.Components[1]
+	assert.Equal(t, trigger.Version, "v0.30.0")
+	assert.Equal(t, trigger.Components[0].Version, testDigest)
+
+	assert.Equal(t, tc.Status.PlatformReport.ConfigMap, PlatformReportConfigMapName)
+	assert.Equal(t, tc.Status.PlatformReport.Components, 2)
+	assert.Equal(t, tc.Status.PlatformReport.Hash, cm.Annotations[v1alpha1.LastAppliedHashKey])
+
+	// the report is not rewritten while the components do not change
+	cm.Data[PlatformReportKey] = "unchanged"
+	_, err = kubeClient.CoreV1().ConfigMaps("tekton-pipelines").Update(ctx, cm, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, r.reconcilePlatformReport(ctx, tc))
+	cm, err = kubeClient.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, PlatformReportConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Data[PlatformReportKey], "unchanged")
+
+	// an upgraded component updates the report
+	tt.Status.Version = "v0.31.0"
+	_, err = operatorClient.OperatorV1alpha1().TektonTriggers().Update(ctx, tt, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+	previousHash := tc.Status.PlatformReport.Hash
+	assert.NilError(t, r.reconcilePlatformReport(ctx, tc))
+	assert.Assert(t, tc.Status.PlatformReport.Hash != previousHash)
+	cm, err = kubeClient.CoreV1().ConfigMaps("tekton-pipelines").Get(ctx, PlatformReportConfigMapName, metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.NilError(t, json.Unmarshal([]byte(cm.Data[PlatformReportKey]), &bom))
+	assert.Equal(t, bom.Components[1].Version, "v0.31.0")
+}
+
+func TestSplitImage(t *testing.T) {
+	tests := []struct {
+		image, repository, tag, digest string
+	}{
+		{"ghcr.io/tektoncd/controller:v1", "ghcr.io/tektoncd/controller", "v1", ""},
+		{"registry:5000/controller", "registry:5000/controller", "", ""},
+		{"registry:5000/controller:v1@" + testDigest, "registry:5000/controller", "v1", testDigest},
+		{"controller@" + testDigest, "controller", "", testDigest},
+	}
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			repository, tag, digest := splitImage(test.image)
+			assert.Equal(t, repository, test.repository)
+			assert.Equal(t, tag, test.tag)
+			assert.Equal(t, digest, test.digest)
+		})
+	}
+}
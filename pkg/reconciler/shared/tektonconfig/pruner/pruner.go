@@ -77,7 +77,7 @@ func GetTektonPrunerCR(config *v1alpha1.TektonConfig, operatorVersion string) *v
 			CommonSpec: v1alpha1.CommonSpec{
 				TargetNamespace: config.Spec.TargetNamespace,
 			},
-			Config: config.Spec.Config,
+			Config: config.Spec.Config.WithOverride(config.Spec.ComponentConfig.TektonPruner),
 			Pruner: config.Spec.TektonPruner,
 		},
 	}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+)
+
+// migratePruner switches from the job based pruner to the event based pruner when TektonConfig is
+// annotated with operator.tekton.dev/migrate-pruner: "true". The translated settings replace the
+// event based pruner config, the settings which could not be translated are reported in the
+// operator.tekton.dev/pruner-migration-report annotation. Returns true if TektonConfig was updated
+func (r *Reconciler) migratePruner(ctx context.Context, tc *v1alpha1.TektonConfig) (bool, error) {
+	annotations := tc.GetAnnotations()
+	if annotations[v1alpha1.MigratePrunerKey] != "true" {
+		return false, nil
+	}
+	logger := logging.FromContext(ctx)
+
+	notes := []string{}
+	// the notes of the translation, the other notes are informational
+	translated := 0
+	if tc.Spec.Pruner.Disabled {
+		notes = append(notes, "the job based pruner is disabled, nothing to migrate")
+	} else {
+		globalConfig, translationNotes, err := common.TranslatePrunerConfig(ctx, r.kubeClientSet, tc)
+		if err != nil {
+			return false, err
+		}
+		notes = append(notes, translationNotes...)
+		translated = len(translationNotes)
+		tc.Spec.TektonPruner.GlobalConfig = globalConfig
+		tc.Spec.TektonPruner.Disabled = ptr.Bool(false)
+		tc.Spec.Pruner.Disabled = true
+	}
+
+	delete(annotations, v1alpha1.MigratePrunerKey)
+	annotations[v1alpha1.PrunerMigrationReportKey] = strings.Join(notes, "\n")
+	tc.SetAnnotations(annotations)
+
+	if _, err := r.operatorClientSet.OperatorV1alpha1().TektonConfigs().Update(ctx, tc, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+	logger.Infow("migrated the job based pruner to the event based pruner", "notes", notes)
+
+	if recorder := controller.GetEventRecorder(ctx); recorder != nil {
+		recorder.Eventf(tc, corev1.EventTypeNormal, "PrunerMigrated",
+			"job based pruner migrated to the event based pruner with %d translation notes, see annotation %s",
+			translated, v1alpha1.PrunerMigrationReportKey)
+	}
+	return true, nil
+}
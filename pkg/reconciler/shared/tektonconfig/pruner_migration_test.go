@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektonconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorFake "github.com/tektoncd/operator/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/pruner/pkg/config"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/ptr"
+)
+
+func TestMigratePruner(t *testing.T) {
+	keep := uint(5)
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		jobPruner    v1alpha1.Prune
+		globalConfig *config.GlobalConfig
+		migrated     bool
+		historyLimit *int32
+		report       string
+		event        string
+	}{
+		{
+			name:         "legacy keep and schedule",
+			annotations:  map[string]string{v1alpha1.MigratePrunerKey: "true"},
+			jobPruner:    v1alpha1.Prune{Keep: &keep, Schedule: "0 8 * * *", Resources: []string{"pipelinerun"}},
+			migrated:     true,
+			historyLimit: ptr.Int32(5),
+			report:       `schedule "0 8 * * *" is dropped`,
+			event:        "with 2 translation notes",
+		},
+		{
+			name:         "already migrated",
+			jobPruner:    v1alpha1.Prune{Disabled: true},
+			globalConfig: &config.GlobalConfig{PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(10)}},
+			historyLimit: ptr.Int32(10),
+		},
+		{
+			name:         "migration requested with the job based pruner disabled",
+			annotations:  map[string]string{v1alpha1.MigratePrunerKey: "true"},
+			jobPruner:    v1alpha1.Prune{Disabled: true},
+			globalConfig: &config.GlobalConfig{PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(10)}},
+			migrated:     true,
+			historyLimit: ptr.Int32(10),
+			report:       "the job based pruner is disabled, nothing to migrate",
+			event:        "with 0 translation notes",
+		},
+		{
+			name:         "both pruners set",
+			annotations:  map[string]string{v1alpha1.MigratePrunerKey: "true"},
+			jobPruner:    v1alpha1.Prune{Keep: &keep, Schedule: "0 8 * * *", Resources: []string{"pipelinerun"}},
+			globalConfig: &config.GlobalConfig{PrunerConfig: config.PrunerConfig{HistoryLimit: ptr.Int32(10)}},
+			migrated:     true,
+			historyLimit: ptr.Int32(5),
+			report:       `schedule "0 8 * * *" is dropped`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			tc := &v1alpha1.TektonConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.ConfigResourceName, Annotations: test.annotations},
+			}
+			tc.Spec.Pruner = test.jobPruner
+			tc.Spec.TektonPruner.GlobalConfig = test.globalConfig
+			operatorClient := operatorFake.NewSimpleClientset(tc.DeepCopy())
+			r := &Reconciler{
+				kubeClientSet:     k8sfake.NewSimpleClientset(),
+				operatorClientSet: operatorClient,
+			}
+
+			migrated, err := r.migratePruner(ctx, tc)
+			assert.NilError(t, err)
+			assert.Equal(t, migrated, test.migrated)
+
+			updated, err := operatorClient.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+			assert.NilError(t, err)
+			assert.Assert(t, updated.Spec.TektonPruner.GlobalConfig != nil)
+			assert.DeepEqual(t, updated.Spec.TektonPruner.GlobalConfig.HistoryLimit, test.historyLimit)
+			// the job based pruner is always disabled after the migration
+			assert.Equal(t, updated.Spec.Pruner.Disabled, test.jobPruner.Disabled || test.migrated)
+			_, requested := updated.Annotations[v1alpha1.MigratePrunerKey]
+			assert.Equal(t, requested, false)
+			if test.migrated && !test.jobPruner.Disabled {
+				assert.DeepEqual(t, updated.Spec.TektonPruner.Disabled, ptr.Bool(false))
+			}
+			if test.migrated {
+				assert.Assert(t, is.Contains(updated.Annotations[v1alpha1.PrunerMigrationReportKey], test.report))
+				assert.Assert(t, is.Contains(<-recorder.Events, test.event))
+			} else {
+				_, reported := updated.Annotations[v1alpha1.PrunerMigrationReportKey]
+				assert.Equal(t, reported, false)
+			}
+		})
+	}
+}
@@ -132,6 +132,11 @@ func UpdateResult(ctx context.Context, old *v1alpha1.TektonResult, new *v1alpha1
 		updated = true
 	}
 
+	if !reflect.DeepEqual(old.Spec.Config, new.Spec.Config) {
+		old.Spec.Config = new.Spec.Config
+		updated = true
+	}
+
 	if !reflect.DeepEqual(old.Spec.Performance, new.Spec.Performance) {
 		old.Spec.Performance = new.Spec.Performance
 		updated = true
@@ -175,6 +180,7 @@ func GetTektonResultCR(config *v1alpha1.TektonConfig, operatorVersion string) *v
 				TargetNamespace: config.Spec.TargetNamespace,
 			},
 			Result: config.Spec.Result,
+			Config: config.Spec.Config.WithOverride(config.Spec.ComponentConfig.Result),
 		},
 	}
 }
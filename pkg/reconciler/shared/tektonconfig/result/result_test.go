@@ -64,6 +64,16 @@ func TestEnsureTektonResultExists(t *testing.T) {
 
 	_, err = EnsureTektonResultExists(ctx, c.OperatorV1alpha1().TektonResults(), tt)
 	util.AssertEqual(t, err, nil)
+
+	// the component config overrides the global config
+	config := getTektonConfig()
+	config.Spec.Config = v1alpha1.Config{PriorityClassName: "global", NodeSelector: map[string]string{"node": "infra"}}
+	config.Spec.ComponentConfig.Result = &v1alpha1.Config{PriorityClassName: "results"}
+	tt = GetTektonResultCR(config, "v0.70.0")
+	util.AssertEqual(t, tt.Spec.Config.PriorityClassName, "results")
+	util.AssertEqual(t, tt.Spec.Config.NodeSelector["node"], "infra")
+	_, err = EnsureTektonResultExists(ctx, c.OperatorV1alpha1().TektonResults(), tt)
+	util.AssertEqual(t, err, v1alpha1.RECONCILE_AGAIN_ERR)
 }
 
 func TestEnsureTektonResultCRNotExists(t *testing.T) {
@@ -27,7 +27,6 @@ import (
 	"github.com/tektoncd/operator/pkg/reconciler/common"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/chain"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pipeline"
-	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/pruner"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/result"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/trigger"
 	"github.com/tektoncd/operator/pkg/reconciler/shared/tektonconfig/upgrade"
@@ -136,6 +135,14 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 		return err
 	}
 
+	// migrate the job based pruner to the event based pruner, if requested
+	if migrated, err := r.migratePruner(ctx, tc); err != nil {
+		logger.Errorw("Failed to migrate the job based pruner", "error", err)
+		return err
+	} else if migrated {
+		return v1alpha1.REQUEUE_EVENT_AFTER
+	}
+
 	// reconcile target namespace
 	nsMetaLabels := map[string]string{}
 	nsMetaAnnotations := map[string]string{}
@@ -153,6 +160,11 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	}
 	logger.Debug("Target namespace reconciled successfully")
 
+	if err := common.ReconcileOperatorObservability(ctx, r.kubeClientSet, tc.Spec.Config.Observability); err != nil {
+		logger.Errorw("Failed to reconcile the observability config of the operator", "error", err)
+		return err
+	}
+
 	// Pre-reconcile extension hooks
 	if err := r.extension.PreReconcile(ctx, tc); err != nil {
 		if err == v1alpha1.RECONCILE_AGAIN_ERR {
@@ -167,117 +179,32 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	tc.Status.MarkPreInstallComplete()
 	logger.Debug("Pre-install completed successfully")
 
-	// Ensure Pipeline CR
-	tektonpipeline := pipeline.GetTektonPipelineCR(tc, r.operatorVersion)
-	logger.Debug("Ensuring TektonPipeline CR exists")
-	if _, err := pipeline.EnsureTektonPipelineExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonPipelines(), tektonpipeline); err != nil {
-		errMsg := fmt.Sprintf("TektonPipeline: %s", err.Error())
-		logger.Errorw("Failed to ensure TektonPipeline exists", "error", err)
-		tc.Status.MarkComponentNotReady(errMsg)
-		if err == v1alpha1.RECONCILE_AGAIN_ERR {
-			return v1alpha1.REQUEUE_EVENT_AFTER
-		}
-		return nil
-	}
-	logger.Debug("TektonPipeline CR reconciled successfully")
-
-	// Start Event based Pruner only if old Job based Pruner is Disabled.
-	if tc.Spec.TektonPruner.IsDisabled() {
-		logger.Infof("TektonPruner is disabled. Shutting down event based pruner")
-		if err := pruner.EnsureTektonPrunerCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonPruners()); err != nil {
-			tc.Status.MarkComponentNotReady(fmt.Sprintf("TektonPruner: %s", err.Error()))
-			return v1alpha1.REQUEUE_EVENT_AFTER
-		}
-	} else if !tc.Spec.Pruner.Disabled {
-		msg := "Invalid Pruner Configuration!! Both pruners, tektonpruner(event based) and pruner(job based) cannot be enabled simultaneously. Please disable one of them."
-		logger.Error(msg)
-		tc.Status.MarkComponentNotReady(msg)
+	// resolve the components installed by the profile
+	profile, err := common.TektonProfile(ctx, r.operatorClientSet, tc.Spec.Profile)
+	if err != nil {
+		logger.Errorw("Failed to resolve the profile", "profile", tc.Spec.Profile, "error", err)
+		tc.Status.MarkComponentNotReady(err.Error())
 		return v1alpha1.REQUEUE_EVENT_AFTER
-	} else {
-		logger.Infof("TektonPruner is enabled.Creating TektonPipeline CR")
-		tektonPruner := pruner.GetTektonPrunerCR(tc, r.operatorVersion)
-		if _, err := pruner.EnsureTektonPrunerExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonPruners(), tektonPruner); err != nil {
-			tc.Status.MarkComponentNotReady(fmt.Sprintf("TektonPruner %s", err.Error()))
-			return v1alpha1.REQUEUE_EVENT_AFTER
-		}
 	}
 
-	// Ensure Pipeline Trigger
-	if !tc.Spec.Trigger.Disabled && (tc.Spec.Profile == v1alpha1.ProfileAll || tc.Spec.Profile == v1alpha1.ProfileBasic) {
-		tektontrigger := trigger.GetTektonTriggerCR(tc, r.operatorVersion)
-		logger.Debug("Ensuring TektonTrigger CR exists")
-		if _, err := trigger.EnsureTektonTriggerExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonTriggers(), tektontrigger); err != nil {
-			errMsg := fmt.Sprintf("TektonTrigger: %s", err.Error())
-			logger.Errorw("Failed to ensure TektonTrigger exists", "error", err)
-			tc.Status.MarkComponentNotReady(errMsg)
-			return v1alpha1.REQUEUE_EVENT_AFTER
-		}
-		logger.Debug("TektonTrigger CR reconciled successfully")
-	} else {
-		logger.Debugw("Ensuring TektonTrigger CR doesn't exist", "profile", tc.Spec.Profile, "triggerDisabled", tc.Spec.Trigger.Disabled)
-		if err := trigger.EnsureTektonTriggerCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonTriggers()); err != nil {
-			errMsg := fmt.Sprintf("TektonTrigger: %s", err.Error())
-			logger.Errorw("Failed to ensure TektonTrigger has been deleted", "error", err)
-			tc.Status.MarkComponentNotReady(errMsg)
-			return v1alpha1.REQUEUE_EVENT_AFTER
-		}
-		logger.Debug("TektonTrigger CR removal reconciled successfully")
-	}
-
-	// Ensure Chain CR
-	if !tc.Spec.Chain.Disabled {
-		tektonchain := chain.GetTektonChainCR(tc, r.operatorVersion)
-		logger.Debug("Ensuring TektonChain CR exists")
-		if _, err := chain.EnsureTektonChainExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonChains(), tektonchain); err != nil {
-			errMsg := fmt.Sprintf("TektonChain: %s", err.Error())
-			logger.Errorw("Failed to ensure TektonChain exists", "error", err)
-			tc.Status.MarkComponentNotReady(errMsg)
-			return v1alpha1.REQUEUE_EVENT_AFTER
-		}
-		logger.Debug("TektonChain CR reconciled successfully")
-	} else {
-		logger.Debugw("Ensuring TektonChain CR doesn't exist", "chainDisabled", tc.Spec.Chain.Disabled)
-		if err := chain.EnsureTektonChainCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonChains()); err != nil {
-			errMsg := fmt.Sprintf("TektonChain: %s", err.Error())
-			logger.Errorw("Failed to ensure TektonChain has been deleted", "error", err)
-			tc.Status.MarkComponentNotReady(errMsg)
-			return v1alpha1.REQUEUE_EVENT_AFTER
-		}
-		logger.Debug("TektonChain CR removal reconciled successfully")
-	}
-
-	// Ensure Result CR
-	if !tc.Spec.Result.Disabled {
-		tektonresult := result.GetTektonResultCR(tc, r.operatorVersion)
-		logger.Debug("Ensuring TektonResult CR exists")
-		if _, err := result.EnsureTektonResultExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonResults(), tektonresult); err != nil {
-			errMsg := fmt.Sprintf("TektonResult %s", err.Error())
-			logger.Errorw("Failed to ensure TektonResult exists", "error", err)
-			tc.Status.MarkComponentNotReady(errMsg)
-			return v1alpha1.REQUEUE_EVENT_AFTER
-		}
-		logger.Debug("TektonResult CR reconciled successfully")
-	} else {
-		logger.Debugw("Ensuring TektonResult CR doesn't exist", "resultDisabled", tc.Spec.Result.Disabled)
-		if err := result.EnsureTektonResultCRNotExists(ctx, r.operatorClientSet.OperatorV1alpha1().TektonResults()); err != nil {
-			errMsg := fmt.Sprintf("TektonResult: %s", err.Error())
-			logger.Errorw("Failed to ensure TektonResult has been deleted", "error", err)
-			tc.Status.MarkComponentNotReady(errMsg)
-			return v1alpha1.REQUEUE_EVENT_AFTER
+	// the defaults of a TektonProfile are merged by the webhook, the TektonConfig is updated
+	// to merge the defaults of an edited profile
+	if !tc.HasProfileDefaults(profile) {
+		logger.Infow("Merging the updated defaults of the profile", "profile", tc.Spec.Profile)
+		if _, err := r.operatorClientSet.OperatorV1alpha1().TektonConfigs().Update(ctx, tc, metav1.UpdateOptions{}); err != nil {
+			return err
 		}
-		logger.Debug("TektonResult CR removal reconciled successfully")
+		return v1alpha1.RECONCILE_AGAIN_ERR
 	}
 
-	// Ensure Pruner
-	if !tc.Spec.Pruner.Disabled {
-		logger.Debugw("Reconciling pruner installer set", "prunerDisabled", tc.Spec.Pruner.Disabled)
-		err := r.reconcilePrunerInstallerSet(ctx, tc)
-		if err != nil {
-			logger.Errorw("Failed to reconcile pruner installer set", "error", err)
-			return err
-		}
-		logger.Debug("Pruner installer set reconciled successfully")
+	// Ensure the components, following their dependencies
+	tc.Status.Components = reconcileComponents(ctx, r.components(tc, profile))
+	if msg := componentsNotReady(tc.Status.Components); msg != "" {
+		logger.Infow("Components not ready", "reason", msg)
+		tc.Status.MarkComponentNotReady(msg)
+		return v1alpha1.REQUEUE_EVENT_AFTER
 	}
+	logger.Debug("Components reconciled successfully")
 
 	// Run resource pruning
 	if err := common.Prune(ctx, r.kubeClientSet, tc); err != nil {
@@ -300,6 +227,11 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, tc *v1alpha1.TektonConfi
 	tc.Status.MarkPostInstallComplete()
 	logger.Debug("Post-install completed successfully")
 
+	// the inventory of the installed components is informative, it does not fail the reconcile
+	if err := r.reconcilePlatformReport(ctx, tc); err != nil {
+		logger.Errorw("Failed to reconcile the platform report", "error", err)
+	}
+
 	// Update the object for any spec changes
 	logger.Debug("Updating TektonConfig status")
 	if _, err := r.operatorClientSet.OperatorV1alpha1().TektonConfigs().UpdateStatus(ctx, tc, metav1.UpdateOptions{}); err != nil {
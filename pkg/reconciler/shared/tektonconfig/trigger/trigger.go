@@ -75,7 +75,7 @@ func GetTektonTriggerCR(config *v1alpha1.TektonConfig, operatorVersion string) *
 			CommonSpec: v1alpha1.CommonSpec{
 				TargetNamespace: config.Spec.TargetNamespace,
 			},
-			Config:  config.Spec.Config,
+			Config:  config.Spec.Config.WithOverride(config.Spec.ComponentConfig.Trigger),
 			Trigger: config.Spec.Trigger,
 		},
 	}
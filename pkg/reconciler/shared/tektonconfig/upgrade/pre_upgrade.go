@@ -25,6 +25,7 @@ import (
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	"github.com/tektoncd/operator/pkg/client/clientset/versioned"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
 	tektonresult "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonresult"
 	"go.uber.org/zap"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
@@ -381,3 +382,32 @@ func removeDeprecatedDisableAffinityAssistant(ctx context.Context, logger *zap.S
 	logger.Info("Successfully removed deprecated disable-affinity-assistant field from TektonConfig CR")
 	return nil
 }
+
+// warnUnsupportedPipelineConfig logs the pipeline settings of the TektonConfig CR which are unknown,
+// deprecated or removed in the Pipelines release about to be installed, the settings are kept as is
+func warnUnsupportedPipelineConfig(ctx context.Context, logger *zap.SugaredLogger, k8sClient kubernetes.Interface, operatorClient versioned.Interface, restConfig *rest.Config) error {
+	keys, err := common.PipelineConfigKeys()
+	if err != nil {
+		logger.Warnw("failed to read the config keys of the Pipelines release, skipping the pipeline config check", "error", err)
+		return nil
+	}
+
+	tcCR, err := operatorClient.OperatorV1alpha1().TektonConfigs().Get(ctx, v1alpha1.ConfigResourceName, metav1.GetOptions{})
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	// the stored config is its own baseline, every finding is reported as a warning
+	errs := tcCR.Spec.Pipeline.ValidateConfig(v1alpha1.WithPipelineConfigKeys(ctx, keys), &tcCR.Spec.Pipeline, "spec.pipeline")
+	if errs == nil {
+		return nil
+	}
+	for _, warning := range errs.WrappedErrors() {
+		logger.Warnw("TektonConfig pipeline config is not supported by the Pipelines release",
+			"message", warning.Message, "paths", warning.Paths)
+	}
+	return nil
+}
@@ -80,8 +80,12 @@ func makeInstallerSet(manifest *mf.Manifest, mt *tisMeta) (*v1alpha1.TektonInsta
 			Annotations:     mt.Annotations,
 			OwnerReferences: mt.OwnerReferences,
 		},
-		Spec: installerSpec(manifest),
 	}
+	spec, err := installerSpec(manifest)
+	if err != nil {
+		return nil, err
+	}
+	is.Spec = spec
 
 	specHash, err := getHash(is.Spec)
 	if err != nil {
@@ -93,10 +97,10 @@ func makeInstallerSet(manifest *mf.Manifest, mt *tisMeta) (*v1alpha1.TektonInsta
 }
 
 // Returns the spec of Installerset
-func installerSpec(manifest *mf.Manifest) v1alpha1.TektonInstallerSetSpec {
-	return v1alpha1.TektonInstallerSetSpec{
-		Manifests: manifest.Resources(),
-	}
+func installerSpec(manifest *mf.Manifest) (v1alpha1.TektonInstallerSetSpec, error) {
+	spec := v1alpha1.TektonInstallerSetSpec{}
+	err := spec.SetManifests(manifest.Resources())
+	return spec, err
 }
 
 // Computes the hash using spec of TektonInstallerSet
@@ -62,7 +62,9 @@ func TestCreateInstallerset(t *testing.T) {
 
 	labels := map[string]string{v1alpha1.CreatedByKey: "pipeline"}
 
-	specHash, err := getHash(installerSpec(&manifest))
+	spec, err := installerSpec(&manifest)
+	assert.NilError(t, err)
+	specHash, err := getHash(spec)
 	assert.NilError(t, err)
 
 	annotations := map[string]string{
@@ -103,7 +105,9 @@ func TestMakeInstallerset(t *testing.T) {
 
 	labels := map[string]string{v1alpha1.CreatedByKey: "pipeline"}
 
-	specHash, err := getHash(installerSpec(&manifest))
+	spec, err := installerSpec(&manifest)
+	assert.NilError(t, err)
+	specHash, err := getHash(spec)
 	assert.NilError(t, err)
 
 	annotations := map[string]string{
@@ -17,10 +17,18 @@ limitations under the License.
 package webhook
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
+	operatorclient "github.com/tektoncd/operator/pkg/client/injection/client"
+	"github.com/tektoncd/operator/pkg/reconciler/common"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
@@ -38,11 +46,12 @@ var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonResult):   &v1alpha1.TektonResult{},
 	v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonChain):    &v1alpha1.TektonChain{},
 	v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonPruner):   &v1alpha1.TektonPruner{},
+	v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonAddon):    &v1alpha1.TektonAddon{},
+	v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonProfile):  &v1alpha1.TektonProfile{},
 }
 
 func SetTypes(platform string) {
 	if platform == "openshift" {
-		types[v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonAddon)] = &v1alpha1.TektonAddon{}
 		types[v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindOpenShiftPipelinesAsCode)] = &v1alpha1.OpenShiftPipelinesAsCode{}
 	} else {
 		types[v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindTektonDashboard)] = &v1alpha1.TektonDashboard{}
@@ -61,9 +70,7 @@ func NewDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher
 		types,
 
 		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
-		func(ctx context.Context) context.Context {
-			return ctx
-		},
+		withOperatorContext(ctx),
 
 		// Whether to disallow unknown fields.
 		true,
@@ -83,15 +90,53 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 		types,
 
 		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
-		func(ctx context.Context) context.Context {
-			return ctx
-		},
+		withOperatorContext(ctx),
 
 		// Whether to disallow unknown fields.
 		true,
 	)
 }
 
+// withOperatorContext lets the defaulting and validation resolve the TektonProfile referenced
+// by a TektonConfig, and check the pipeline config against the bundled Pipelines release
+func withOperatorContext(ctx context.Context) func(context.Context) context.Context {
+	profiles := operatorclient.Get(ctx).OperatorV1alpha1().TektonProfiles()
+	pipelineConfigKeys, err := common.PipelineConfigKeys()
+	if err != nil {
+		logging.FromContext(ctx).Warnw("The pipeline config is not validated against the Pipelines release", "error", err)
+	}
+	return func(ctx context.Context) context.Context {
+		if pipelineConfigKeys != nil {
+			ctx = v1alpha1.WithPipelineConfigKeys(ctx, pipelineConfigKeys)
+		}
+		if raw := requestObject(ctx); raw != nil {
+			ctx = v1alpha1.WithRequestObject(ctx, raw)
+		}
+		return v1alpha1.WithProfileGetter(ctx, func(ctx context.Context, name string) (*v1alpha1.TektonProfile, error) {
+			return profiles.Get(ctx, name, metav1.GetOptions{})
+		})
+	}
+}
+
+// requestObject returns the raw object of the admission request, the typed object passed to the
+// defaulting has lost the fields set to their zero value
+func requestObject(ctx context.Context) []byte {
+	r := apis.GetHTTPRequest(ctx)
+	if r == nil || r.Body == nil {
+		return nil
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+	review := admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, &review); err != nil || review.Request == nil {
+		return nil
+	}
+	return review.Request.Object.Raw
+}
+
 func NewConfigValidationController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 	return configmaps.NewAdmissionController(ctx,
 		// Name of the configmap webhook.
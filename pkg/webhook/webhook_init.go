@@ -198,9 +198,9 @@ func makeInstallerSet(manifest mf.Manifest) (*v1alpha1.TektonInstallerSet, error
 			GenerateName: fmt.Sprintf("%s-", "validating-mutating-webhook"),
 			Labels:       _primaryLabelSelector.MatchLabels,
 		},
-		Spec: v1alpha1.TektonInstallerSetSpec{
-			Manifests: manifest.Resources(),
-		},
+	}
+	if err := installerSet.Spec.SetManifests(manifest.Resources()); err != nil {
+		return nil, err
 	}
 
 	return installerSet, nil
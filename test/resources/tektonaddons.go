@@ -36,6 +36,7 @@ import (
 
 	"github.com/tektoncd/operator/pkg/apis/operator/v1alpha1"
 	addonv1alpha1 "github.com/tektoncd/operator/pkg/client/clientset/versioned/typed/operator/v1alpha1"
+	k8sAddon "github.com/tektoncd/operator/pkg/reconciler/kubernetes/tektonaddon"
 	"github.com/tektoncd/operator/pkg/reconciler/openshift/tektonaddon"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -97,12 +98,12 @@ func AssertTektonAddonCRReadyStatus(t *testing.T, clients *utils.Clients, names
 // AssertTektonInstallerSets verifies if the TektonInstallerSets are created.
 func AssertTektonInstallerSets(t *testing.T, clients *utils.Clients) {
 	assertInstallerSets(t, clients, tektonaddon.PipelinesTemplateInstallerSet)
-	assertInstallerSets(t, clients, tektonaddon.ResolverTaskInstallerSet)
-	assertInstallerSets(t, clients, tektonaddon.ResolverStepActionInstallerSet)
+	assertInstallerSets(t, clients, k8sAddon.ResolverTaskInstallerSet)
+	assertInstallerSets(t, clients, k8sAddon.ResolverStepActionInstallerSet)
 	assertInstallerSets(t, clients, tektonaddon.TriggersResourcesInstallerSet)
 	assertInstallerSets(t, clients, tektonaddon.ConsoleCLIInstallerSet)
 	assertInstallerSets(t, clients, tektonaddon.MiscellaneousResourcesInstallerSet)
-	assertInstallerSets(t, clients, tektonaddon.CommunityResolverTaskInstallerSet)
+	assertInstallerSets(t, clients, k8sAddon.CommunityResolverTaskInstallerSet)
 }
 
 func assertInstallerSets(t *testing.T, clients *utils.Clients, component string) {